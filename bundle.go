@@ -0,0 +1,478 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// referenceBundler imports externally referenced schemas into root document definitions.
+type referenceBundler struct {
+	loader      Loader
+	order       keyOrder
+	documents   map[string]any
+	failed      string
+	imported    map[string]string
+	reserved    map[string]struct{}
+	root        map[string]any
+	defsKeyword string
+	rootPath    string
+	rootID      string
+}
+
+// BundleFile reads schema from file and returns single-file schema with external references merged.
+func BundleFile(path string, opt Options) ([]byte, error) {
+	schemaBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrReadSchemaFile, err)
+	}
+
+	if strings.TrimSpace(opt.SourcePath) == "" {
+		opt.SourcePath = path
+	}
+
+	if strings.TrimSpace(opt.BaseDir) == "" {
+		opt.BaseDir = filepath.Dir(path)
+	}
+
+	return Bundle(schemaBytes, opt)
+}
+
+// Bundle returns single-file schema where external references are merged into local definitions.
+//
+// Referenced definitions are stored under `$defs` (or `definitions` when schema already uses it)
+// and every external `$ref` is rewritten to a local JSON pointer. Absolute URI references are
+// loaded through Options.Loader and left unchanged when no loader is configured.
+func Bundle(schemaBytes []byte, opt Options) ([]byte, error) {
+	order := make(keyOrder)
+	root, err := decodeSchema(schemaBytes, order)
+	if err != nil {
+		return nil, err
+	}
+
+	canonicalizeReferences(root, "")
+	if err := bundleReferences(root, opt, order); err != nil {
+		return nil, err
+	}
+
+	data, err := marshalIndentedJSON(order.ordered(root))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrEncodeSchemaJSON, err)
+	}
+
+	return data, nil
+}
+
+// ReferencedDocuments returns sorted locations of external documents referenced from schema, transitively.
+//
+// Locations are absolute file paths of relative and file references, and of absolute URIs
+// mapped to directories with MapLoader.MapDir; other URIs loaded through Options.Loader are kept as is.
+// Tools use it to know which files affect generated output.
+//
+// On reference error it also returns locations discovered so far, including the one that failed to load,
+// so watchers can keep watching a broken reference until it is fixed.
+func ReferencedDocuments(schemaBytes []byte, opt Options) ([]string, error) {
+	order := make(keyOrder)
+	root, err := decodeSchema(schemaBytes, order)
+	if err != nil {
+		return nil, err
+	}
+
+	rootObject, ok := root.(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+
+	canonicalizeReferences(rootObject, "")
+	bundler := newReferenceBundler(rootObject, opt)
+	bundler.order = order
+	err = bundler.rewrite(rootObject, "", referenceBaseDir(opt))
+
+	locations := sortedKeys(bundler.documents)
+	if err != nil && bundler.failed != "" {
+		locations = append(locations, bundler.failed)
+	}
+
+	if pathLoader, ok := opt.Loader.(localPathLoader); ok {
+		for index, location := range locations {
+			if !isSchemaURI(location) {
+				continue
+			}
+
+			if path, ok := pathLoader.localPath(location); ok {
+				locations[index] = path
+			}
+		}
+	}
+
+	slices.Sort(locations)
+	return slices.Compact(locations), err
+}
+
+// bundleReferences rewrites external references of decoded root schema into local definitions in place.
+func bundleReferences(root any, opt Options, order keyOrder) error {
+	rootObject, ok := root.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	bundler := newReferenceBundler(rootObject, opt)
+	bundler.order = order
+	return bundler.rewrite(rootObject, "", referenceBaseDir(opt))
+}
+
+// newReferenceBundler prepares bundler state and reserves already declared definition names.
+func newReferenceBundler(root map[string]any, opt Options) *referenceBundler {
+	bundler := &referenceBundler{
+		documents:   make(map[string]any),
+		imported:    make(map[string]string),
+		reserved:    make(map[string]struct{}),
+		root:        root,
+		defsKeyword: "$defs",
+		loader:      opt.Loader,
+		rootID:      absoluteDocumentURI(asString(root["$id"])),
+	}
+
+	if _, ok := root["$defs"].(map[string]any); !ok {
+		if _, ok := root["definitions"].(map[string]any); ok {
+			bundler.defsKeyword = "definitions"
+		}
+	}
+
+	for _, keyword := range []string{"$defs", "definitions"} {
+		values, _ := root[keyword].(map[string]any)
+		for name := range values {
+			bundler.reserved[name] = struct{}{}
+		}
+	}
+
+	if sourcePath := strings.TrimSpace(opt.SourcePath); sourcePath != "" {
+		if absolute, err := filepath.Abs(sourcePath); err == nil {
+			bundler.rootPath = absolute
+		}
+	}
+
+	return bundler
+}
+
+// referenceBaseDir returns absolute directory used to resolve root-level relative references.
+func referenceBaseDir(opt Options) string {
+	dir := strings.TrimSpace(opt.BaseDir)
+	if dir == "" {
+		dir = "."
+	}
+
+	absolute, err := filepath.Abs(dir)
+	if err != nil {
+		return dir
+	}
+
+	return absolute
+}
+
+// rewrite walks schema tree and replaces external references with local definition pointers.
+//
+// Only subschema keywords are walked, so instance data such as `default` or `enum` values is never
+// rewritten, while properties with those names are. Location is the absolute file path or URI
+// of document owning the node, or empty string for root document.
+func (bundler *referenceBundler) rewrite(node any, location, dir string) error {
+	object, ok := node.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	for _, keyword := range referenceKeywords {
+		if _, ok := object[keyword]; !ok {
+			continue
+		}
+
+		if err := bundler.rewriteReference(object, keyword, location, dir); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	forEachSubschema(object, "", func(_ string, child map[string]any) {
+		if err == nil {
+			err = bundler.rewrite(child, location, dir)
+		}
+	})
+
+	return err
+}
+
+// rewriteReference resolves one reference keyword and points it to local definition when target is external.
+func (bundler *referenceBundler) rewriteReference(object map[string]any, keyword, location, dir string) error {
+	ref := asString(object[keyword])
+	target, fragment, ok := resolveReferenceTarget(ref, location, dir)
+	if !ok {
+		return nil
+	}
+
+	if target == bundler.rootPath || target == bundler.rootID {
+		object[keyword] = "#" + fragment
+		return nil
+	}
+
+	if isSchemaURI(target) && bundler.loader == nil {
+		return nil
+	}
+
+	name, err := bundler.importDefinition(target, fragment)
+	if err != nil {
+		return fmt.Errorf("%w %q: %w", ErrResolveSchemaReference, ref, err)
+	}
+
+	object[keyword] = "#/" + bundler.defsKeyword + "/" + encodeJSONPointerToken(name)
+	return nil
+}
+
+// importDefinition copies referenced schema into root definitions and returns local definition name.
+//
+// Fragment is either JSON pointer or plain-name anchor declared in target document.
+func (bundler *referenceBundler) importDefinition(location, fragment string) (string, error) {
+	document, err := bundler.loadDocument(location)
+	if err != nil {
+		return "", err
+	}
+
+	pointer := fragment
+	if fragment != "" && !strings.HasPrefix(fragment, "/") {
+		object, _ := document.(map[string]any)
+		resolved, ok := buildSchemaIndex(object, "").resolve("#"+fragment, schemaBaseURI(object, ""))
+		if !ok {
+			return "", fmt.Errorf("anchor %q not found in %q", fragment, location)
+		}
+
+		pointer = resolved
+	}
+
+	key := location + "#" + pointer
+	if name, ok := bundler.imported[key]; ok {
+		return name, nil
+	}
+
+	target, ok := resolveJSONPointer(document, "#"+pointer)
+	if !ok {
+		return "", fmt.Errorf("pointer %q not found in %q", pointer, location)
+	}
+
+	name := bundler.definitionName(location, pointer)
+	bundler.imported[key] = name
+	bundler.reserved[name] = struct{}{}
+
+	value := bundler.order.clone(target)
+	if object, ok := value.(map[string]any); ok && pointer == "" {
+		for _, keyword := range []string{"$schema", "$id", "id", "$defs", "definitions"} {
+			delete(object, keyword)
+		}
+	}
+
+	if err := bundler.rewrite(value, location, locationDir(location)); err != nil {
+		return "", err
+	}
+
+	bundler.definitions()[name] = value
+	return name, nil
+}
+
+// loadDocument reads and decodes external schema document with per-location caching.
+//
+// File paths are read from disk, absolute URIs are loaded through configured Loader.
+func (bundler *referenceBundler) loadDocument(location string) (any, error) {
+	if document, ok := bundler.documents[location]; ok {
+		return document, nil
+	}
+
+	var data []byte
+	var err error
+	if isSchemaURI(location) {
+		data, err = bundler.loader.Load(location)
+	} else {
+		data, err = os.ReadFile(location)
+	}
+
+	if err != nil {
+		bundler.failed = location
+		return nil, fmt.Errorf("%w: %w", ErrLoadSchemaReference, err)
+	}
+
+	document, err := decodeSchema(data, bundler.order)
+	if err != nil {
+		bundler.failed = location
+		return nil, fmt.Errorf("%w %q: %w", ErrLoadSchemaReference, location, err)
+	}
+
+	base := ""
+	if isSchemaURI(location) {
+		base = location
+	}
+
+	canonicalizeReferences(document, base)
+
+	bundler.documents[location] = document
+	return document, nil
+}
+
+// definitions returns root definition map and creates it on first import.
+func (bundler *referenceBundler) definitions() map[string]any {
+	defs, ok := bundler.root[bundler.defsKeyword].(map[string]any)
+	if !ok {
+		defs = make(map[string]any)
+		bundler.root[bundler.defsKeyword] = defs
+	}
+
+	return defs
+}
+
+// definitionName selects unique definition name from pointer tail or document file name.
+func (bundler *referenceBundler) definitionName(location, pointer string) string {
+	name := filepath.Base(location)
+	if parsed, err := url.Parse(location); err == nil && isSchemaURI(location) {
+		name = path.Base(parsed.Path)
+	}
+
+	stem := strings.TrimSuffix(name, path.Ext(name))
+	if stem == "" || stem == "." || stem == "/" {
+		stem = "external"
+	}
+
+	base := stem
+	if tokens := strings.Split(strings.Trim(pointer, "/"), "/"); tokens[len(tokens)-1] != "" {
+		base = decodeJSONPointerToken(tokens[len(tokens)-1])
+	}
+
+	if _, taken := bundler.reserved[base]; !taken {
+		return base
+	}
+
+	candidate := base
+	if base != stem {
+		candidate = stem + "_" + base
+	}
+
+	for index := 2; ; index++ {
+		if _, taken := bundler.reserved[candidate]; !taken {
+			return candidate
+		}
+
+		candidate = base + "_" + strconv.Itoa(index)
+	}
+}
+
+// resolveReferenceTarget resolves reference to target document location and JSON pointer fragment.
+//
+// Relative references resolve against owning URI document or directory of owning file.
+// It reports false for root-local references; embedded `$id` and anchor targets are
+// canonicalized before bundling.
+func resolveReferenceTarget(ref, location, dir string) (string, string, bool) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return "", "", false
+	}
+
+	target, fragment, _ := strings.Cut(ref, "#")
+
+	if target == "" {
+		if location == "" {
+			return "", "", false
+		}
+
+		return location, fragment, true
+	}
+
+	if isSchemaURI(target) {
+		return absoluteDocumentURI(target), fragment, true
+	}
+
+	if isSchemaURI(location) {
+		base, err := url.Parse(location)
+		if err != nil {
+			return "", "", false
+		}
+
+		relative, err := url.Parse(target)
+		if err != nil {
+			return "", "", false
+		}
+
+		return absoluteDocumentURI(base.ResolveReference(relative).String()), fragment, true
+	}
+
+	filePath := filepath.FromSlash(target)
+	if !filepath.IsAbs(filePath) {
+		filePath = filepath.Join(dir, filePath)
+	}
+
+	return filepath.Clean(filePath), fragment, true
+}
+
+// isSchemaURI reports whether value is absolute URI with scheme rather than file path.
+//
+// Single-letter schemes are treated as Windows drive letters.
+func isSchemaURI(value string) bool {
+	parsed, err := url.Parse(strings.TrimSpace(value))
+	if err != nil {
+		return false
+	}
+
+	return len(parsed.Scheme) > 1
+}
+
+// absoluteDocumentURI returns absolute URI without fragment or empty string for relative values.
+func absoluteDocumentURI(value string) string {
+	value = strings.TrimSpace(value)
+	if !isSchemaURI(value) {
+		return ""
+	}
+
+	value, _, _ = strings.Cut(value, "#")
+	if strings.HasPrefix(value, "file://") {
+		if parsed, err := url.Parse(value); err == nil {
+			return filepath.Clean(filepath.FromSlash(parsed.Path))
+		}
+	}
+
+	return value
+}
+
+// locationDir returns directory for file locations and empty string for URI locations.
+func locationDir(location string) string {
+	if isSchemaURI(location) {
+		return ""
+	}
+
+	return filepath.Dir(location)
+}
+
+// encodeJSONPointerToken escapes one JSON pointer token.
+func encodeJSONPointerToken(token string) string {
+	token = strings.ReplaceAll(token, "~", "~0")
+	token = strings.ReplaceAll(token, "/", "~1")
+	return token
+}
+
+// marshalIndentedJSON serializes value as pretty JSON without HTML escaping.
+func marshalIndentedJSON(value any) ([]byte, error) {
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
@@ -0,0 +1,268 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRenderFileResolvesExternalReferences(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeMultiFileSchemaFixture(t)
+	rendered, err := RenderFile(schemaPath, Options{})
+	if err != nil {
+		t.Fatalf("RenderFile: %v", err)
+	}
+
+	assertContains(t, rendered, "## TLS")
+	assertContains(t, rendered, "## CipherSuite")
+	assertContains(t, rendered, "### TLS.cert_file")
+	assertContains(t, rendered, "Path: `server.tls.cert_file`")
+	assertContains(t, rendered, "Reference: [`#/$defs/TLS`](#tls)")
+	assertContains(t, rendered, "Path: `server.tls.ciphers.[].name`")
+}
+
+func TestGenerateExampleFileResolvesExternalReferences(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeMultiFileSchemaFixture(t)
+	data, err := GenerateExampleFile(schemaPath, Options{ExampleFormat: ExampleFormatJSON})
+	if err != nil {
+		t.Fatalf("GenerateExampleFile: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal generated json: %v", err)
+	}
+
+	server, _ := got["server"].(map[string]any)
+	tls, _ := server["tls"].(map[string]any)
+	if tls["cert_file"] != "/etc/tls/cert.pem" {
+		t.Fatalf("external reference was not resolved in example:\n%s", string(data))
+	}
+}
+
+func TestBundleFileMergesExternalDefinitions(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeMultiFileSchemaFixture(t)
+	data, err := BundleFile(schemaPath, Options{})
+	if err != nil {
+		t.Fatalf("BundleFile: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal bundled schema: %v", err)
+	}
+
+	defs, _ := got["$defs"].(map[string]any)
+	for _, name := range []string{"Config", "server", "TLS", "CipherSuite"} {
+		if _, ok := defs[name]; !ok {
+			t.Fatalf("bundled schema misses definition %q:\n%s", name, string(data))
+		}
+	}
+
+	assertNotContains(t, string(data), "tls.json")
+	assertContains(t, string(data), `"$ref": "#/$defs/CipherSuite"`)
+}
+
+func TestBundleRewritesPropertiesNamedAsDataKeywords(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "common", "tls.json"), `{
+  "$defs": {
+    "TLS": { "type": "object", "properties": { "cert_file": { "type": "string" } } }
+  }
+}`)
+
+	schemaBytes := []byte(`{
+  "type": "object",
+  "properties": {
+    "default": { "$ref": "common/tls.json#/$defs/TLS" },
+    "mode": { "type": "object", "default": { "$ref": "common/tls.json#/$defs/TLS" } }
+  }
+}`)
+
+	data, err := Bundle(schemaBytes, Options{BaseDir: dir})
+	if err != nil {
+		t.Fatalf("Bundle: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal bundled schema: %v", err)
+	}
+
+	properties, _ := got["properties"].(map[string]any)
+	property, _ := properties["default"].(map[string]any)
+	if property["$ref"] != "#/$defs/TLS" {
+		t.Fatalf("property named default was not bundled:\n%s", string(data))
+	}
+
+	mode, _ := properties["mode"].(map[string]any)
+	value, _ := mode["default"].(map[string]any)
+	if value["$ref"] != "common/tls.json#/$defs/TLS" {
+		t.Fatalf("default value was rewritten:\n%s", string(data))
+	}
+}
+
+func TestBundleRenamesCollidingDefinitions(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "common", "tls.json"), `{
+  "$defs": {
+    "Config": { "type": "object", "properties": { "cert_file": { "type": "string" } } }
+  }
+}`)
+
+	schemaBytes := []byte(`{
+  "$ref": "#/$defs/Config",
+  "$defs": {
+    "Config": {
+      "type": "object",
+      "properties": { "tls": { "$ref": "common/tls.json#/$defs/Config" } }
+    }
+  }
+}`)
+
+	data, err := Bundle(schemaBytes, Options{BaseDir: dir})
+	if err != nil {
+		t.Fatalf("Bundle: %v", err)
+	}
+
+	assertContains(t, string(data), `"$ref": "#/$defs/tls_Config"`)
+}
+
+func TestReferencedDocumentsListsTransitiveFiles(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeMultiFileSchemaFixture(t)
+	schemaBytes, err := os.ReadFile(schemaPath)
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	dir, err := filepath.Abs(filepath.Dir(schemaPath))
+	if err != nil {
+		t.Fatalf("abs fixture dir: %v", err)
+	}
+
+	documents, err := ReferencedDocuments(schemaBytes, Options{SourcePath: schemaPath, BaseDir: dir})
+	if err != nil {
+		t.Fatalf("ReferencedDocuments: %v", err)
+	}
+
+	want := []string{filepath.Join(dir, "common", "tls.json"), filepath.Join(dir, "server.json")}
+	if strings.Join(documents, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected documents: %q, want %q", documents, want)
+	}
+}
+
+func TestReferencedDocumentsListsDiscoveredFilesOnError(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeMultiFileSchemaFixture(t)
+	schemaBytes, err := os.ReadFile(schemaPath)
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	dir, err := filepath.Abs(filepath.Dir(schemaPath))
+	if err != nil {
+		t.Fatalf("abs fixture dir: %v", err)
+	}
+
+	if err := os.Remove(filepath.Join(dir, "common", "tls.json")); err != nil {
+		t.Fatalf("remove fixture file: %v", err)
+	}
+
+	documents, err := ReferencedDocuments(schemaBytes, Options{SourcePath: schemaPath, BaseDir: dir})
+	if !errors.Is(err, ErrLoadSchemaReference) {
+		t.Fatalf("expected ErrLoadSchemaReference, got: %v", err)
+	}
+
+	want := []string{filepath.Join(dir, "common", "tls.json"), filepath.Join(dir, "server.json")}
+	if strings.Join(documents, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected documents: %q, want %q", documents, want)
+	}
+}
+
+func TestRenderReturnsErrorForMissingExternalReference(t *testing.T) {
+	t.Parallel()
+
+	_, err := Render([]byte(`{"$ref": "missing.json#/$defs/Config"}`), Options{BaseDir: t.TempDir()})
+	if !errors.Is(err, ErrResolveSchemaReference) || !errors.Is(err, ErrLoadSchemaReference) {
+		t.Fatalf("expected reference resolution error, got: %v", err)
+	}
+}
+
+// writeMultiFileSchemaFixture writes root schema referencing definitions from nested files.
+func writeMultiFileSchemaFixture(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "common", "tls.json"), `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$defs": {
+    "TLS": {
+      "type": "object",
+      "properties": {
+        "cert_file": { "type": "string", "default": "/etc/tls/cert.pem" },
+        "ciphers": { "type": "array", "items": { "$ref": "#/$defs/CipherSuite" } }
+      }
+    },
+    "CipherSuite": {
+      "type": "object",
+      "properties": { "name": { "type": "string" } }
+    }
+  }
+}`)
+
+	writeTestFile(t, filepath.Join(dir, "server.json"), `{
+  "type": "object",
+  "properties": {
+    "tls": { "$ref": "common/tls.json#/$defs/TLS" }
+  }
+}`)
+
+	schemaPath := filepath.Join(dir, "config.json")
+	writeTestFile(t, schemaPath, `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$ref": "#/$defs/Config",
+  "$defs": {
+    "Config": {
+      "type": "object",
+      "properties": {
+        "server": { "$ref": "server.json" }
+      }
+    }
+  }
+}`)
+
+	return schemaPath
+}
+
+// writeTestFile writes test fixture file and creates parent directories.
+func writeTestFile(t *testing.T, path, body string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		t.Fatalf("create fixture dir: %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte(strings.TrimSpace(body)+"\n"), 0o600); err != nil {
+		t.Fatalf("write fixture %q: %v", path, err)
+	}
+}
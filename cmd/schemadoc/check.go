@@ -0,0 +1,285 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/woozymasta/schemadoc"
+)
+
+const (
+	// diffContextLines is number of unchanged lines shown around changes in unified diff.
+	diffContextLines = 3
+	// maxDiffCells caps line comparison table size; larger changes are shown as full replacement.
+	maxDiffCells = 16 << 20
+)
+
+// errCheckOutputPath is returned when drift check has no output file to compare with.
+var errCheckOutputPath = errors.New("--check requires output file or --output-dir")
+
+// diffOp is one line of edit script: ' ' keeps, '-' removes and '+' adds line.
+type diffOp struct {
+	Line string
+	Kind byte
+}
+
+// checkOutput compares generated content with existing output file and prints unified diff on drift.
+//
+// Missing file is compared as empty, so check fails until output is generated once.
+func (runner *cliRunner) checkOutput(content []byte, formatName, outputPath string) error {
+	outputPath = strings.TrimSpace(outputPath)
+	if outputPath == "" {
+		return errCheckOutputPath
+	}
+
+	drift, err := runner.diffOutputFile(outputPath, string(content))
+	if err != nil {
+		return err
+	}
+
+	if drift {
+		return fmt.Errorf("%s file %q is out of date; regenerate it without --check", formatName, outputPath)
+	}
+
+	return nil
+}
+
+// checkPages compares multi-page markdown with page files in output directory and prints unified diffs.
+//
+// Files in directory that are not generated pages are ignored.
+func (runner *cliRunner) checkPages(schemaBytes []byte, renderOptions schemadoc.Options, outputDir string) error {
+	stale, total, err := runner.diffPages(schemaBytes, renderOptions, outputDir)
+	if err != nil {
+		return err
+	}
+
+	if stale > 0 {
+		return fmt.Errorf("%d of %d markdown pages in %q are out of date; regenerate them without --check", stale, total, outputDir)
+	}
+
+	return nil
+}
+
+// diffPages prints unified diffs of stale page files and returns numbers of stale and generated pages.
+func (runner *cliRunner) diffPages(schemaBytes []byte, renderOptions schemadoc.Options, outputDir string) (int, int, error) {
+	pages, err := schemadoc.RenderPages(schemaBytes, renderOptions)
+	if err != nil {
+		return 0, 0, fmt.Errorf("render markdown pages: %w", err)
+	}
+
+	stale := 0
+	for _, page := range pages {
+		drift, err := runner.diffOutputFile(filepath.Join(outputDir, page.Path), page.Content)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if drift {
+			stale++
+		}
+	}
+
+	return stale, len(pages), nil
+}
+
+// diffOutputFile prints unified diff between existing file and generated content and reports drift.
+func (runner *cliRunner) diffOutputFile(path, generated string) (bool, error) {
+	existing, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return false, fmt.Errorf("read output file %q: %w", path, err)
+	}
+
+	if string(existing) == generated {
+		return false, nil
+	}
+
+	if _, err := runner.stdout.Write([]byte(unifiedDiff(path, string(existing), generated))); err != nil {
+		return false, fmt.Errorf("write diff to stdout: %w", err)
+	}
+
+	return true, nil
+}
+
+// unifiedDiff returns unified diff from existing to generated text with file headers.
+func unifiedDiff(path, existing, generated string) string {
+	ops := diffLines(splitLines(existing), splitLines(generated))
+
+	var out strings.Builder
+	out.WriteString("--- " + path + "\n")
+	out.WriteString("+++ " + path + " (generated)\n")
+
+	for start := 0; start < len(ops); {
+		if ops[start].Kind == ' ' {
+			start++
+			continue
+		}
+
+		end := start
+		for next := start; next < len(ops); next++ {
+			if ops[next].Kind == ' ' {
+				continue
+			}
+
+			if next-end > 2*diffContextLines {
+				break
+			}
+
+			end = next + 1
+		}
+
+		first := max(start-diffContextLines, 0)
+		last := min(end+diffContextLines, len(ops))
+		writeDiffHunk(&out, ops, first, last)
+		start = last
+	}
+
+	return out.String()
+}
+
+// writeDiffHunk writes `@@` header and lines of edit script range as one hunk.
+func writeDiffHunk(out *strings.Builder, ops []diffOp, first, last int) {
+	oldStart, newStart := 1, 1
+	for _, op := range ops[:first] {
+		if op.Kind != '+' {
+			oldStart++
+		}
+
+		if op.Kind != '-' {
+			newStart++
+		}
+	}
+
+	oldCount, newCount := 0, 0
+	for _, op := range ops[first:last] {
+		if op.Kind != '+' {
+			oldCount++
+		}
+
+		if op.Kind != '-' {
+			newCount++
+		}
+	}
+
+	out.WriteString("@@ -" + diffRange(oldStart, oldCount) + " +" + diffRange(newStart, newCount) + " @@\n")
+	for _, op := range ops[first:last] {
+		out.WriteByte(op.Kind)
+		out.WriteString(op.Line)
+		if !strings.HasSuffix(op.Line, "\n") {
+			out.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// diffRange formats hunk range; empty range points at line before it as in diff(1).
+func diffRange(start, count int) string {
+	switch count {
+	case 0:
+		return strconv.Itoa(start-1) + ",0"
+	case 1:
+		return strconv.Itoa(start)
+	default:
+		return strconv.Itoa(start) + "," + strconv.Itoa(count)
+	}
+}
+
+// splitLines splits text into lines keeping line terminators.
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// diffLines returns shortest edit script turning old lines into new ones.
+//
+// Common prefix and suffix are matched directly; remaining lines are aligned by
+// longest common subsequence unless comparison table exceeds maxDiffCells.
+func diffLines(oldLines, newLines []string) []diffOp {
+	prefix := 0
+	for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix &&
+		oldLines[len(oldLines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]diffOp, 0, len(oldLines)+len(newLines))
+	for _, line := range oldLines[:prefix] {
+		ops = append(ops, diffOp{Kind: ' ', Line: line})
+	}
+
+	ops = append(ops, diffMiddle(oldLines[prefix:len(oldLines)-suffix], newLines[prefix:len(newLines)-suffix])...)
+	for _, line := range oldLines[len(oldLines)-suffix:] {
+		ops = append(ops, diffOp{Kind: ' ', Line: line})
+	}
+
+	return ops
+}
+
+// diffMiddle aligns lines by longest common subsequence table.
+func diffMiddle(oldLines, newLines []string) []diffOp {
+	ops := make([]diffOp, 0, len(oldLines)+len(newLines))
+	width := len(newLines) + 1
+	if (len(oldLines)+1)*width > maxDiffCells {
+		for _, line := range oldLines {
+			ops = append(ops, diffOp{Kind: '-', Line: line})
+		}
+
+		for _, line := range newLines {
+			ops = append(ops, diffOp{Kind: '+', Line: line})
+		}
+
+		return ops
+	}
+
+	common := make([]int32, (len(oldLines)+1)*width)
+	for i := len(oldLines) - 1; i >= 0; i-- {
+		for j := len(newLines) - 1; j >= 0; j-- {
+			if oldLines[i] == newLines[j] {
+				common[i*width+j] = common[(i+1)*width+j+1] + 1
+			} else {
+				common[i*width+j] = max(common[(i+1)*width+j], common[i*width+j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(oldLines) && j < len(newLines) {
+		switch {
+		case oldLines[i] == newLines[j]:
+			ops = append(ops, diffOp{Kind: ' ', Line: oldLines[i]})
+			i++
+			j++
+		case common[(i+1)*width+j] >= common[i*width+j+1]:
+			ops = append(ops, diffOp{Kind: '-', Line: oldLines[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{Kind: '+', Line: newLines[j]})
+			j++
+		}
+	}
+
+	for ; i < len(oldLines); i++ {
+		ops = append(ops, diffOp{Kind: '-', Line: oldLines[i]})
+	}
+
+	for ; j < len(newLines); j++ {
+		ops = append(ops, diffOp{Kind: '+', Line: newLines[j]})
+	}
+
+	return ops
+}
@@ -0,0 +1,341 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/woozymasta/schemadoc"
+)
+
+// projectFileNames lists project file names looked up in current directory, in priority order.
+var projectFileNames = []string{"schemadoc.yaml", "schemadoc.yml", "schemadoc.json"}
+
+// errProjectFileNotFound is returned when project file is not given and none of default names exists.
+var errProjectFileNotFound = errors.New("project file not found; expected schemadoc.yaml, schemadoc.yml or schemadoc.json")
+
+// runGenerate runs selected jobs of project file; with check, outputs are compared instead of written.
+//
+// In check mode every job runs, so one invocation reports all stale outputs.
+func (runner *cliRunner) runGenerate(projectPath string, jobNames []string, check bool) error {
+	projectPath, err := resolveProjectPath(projectPath)
+	if err != nil {
+		return err
+	}
+
+	project, err := schemadoc.LoadProjectFile(projectPath)
+	if err != nil {
+		return fmt.Errorf("load project %q: %w", projectPath, err)
+	}
+
+	jobs, err := selectProjectJobs(project.Jobs, jobNames)
+	if err != nil {
+		return err
+	}
+
+	projectDir := filepath.Dir(projectPath)
+	stale, total := 0, 0
+	for _, job := range jobs {
+		jobStale, err := runner.runProjectJob(job, projectDir, check)
+		if err != nil {
+			return fmt.Errorf("job %s: %w", projectJobLabel(job), err)
+		}
+
+		stale += jobStale
+		total += len(job.Outputs)
+	}
+
+	if stale > 0 {
+		return fmt.Errorf("%d of %d project outputs are out of date; regenerate them without --check", stale, total)
+	}
+
+	return nil
+}
+
+// resolveProjectPath returns given project file path or first default project file in current directory.
+func resolveProjectPath(projectPath string) (string, error) {
+	if projectPath = strings.TrimSpace(projectPath); projectPath != "" {
+		return projectPath, nil
+	}
+
+	for _, name := range projectFileNames {
+		if _, err := os.Stat(name); err == nil {
+			return name, nil
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("stat project file %q: %w", name, err)
+		}
+	}
+
+	return "", errProjectFileNotFound
+}
+
+// selectProjectJobs returns jobs named in selection, or all jobs when selection is empty.
+func selectProjectJobs(jobs []schemadoc.ProjectJob, names []string) ([]schemadoc.ProjectJob, error) {
+	if len(names) == 0 {
+		return jobs, nil
+	}
+
+	selected := make([]schemadoc.ProjectJob, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, job := range jobs {
+			if job.Name == name {
+				selected = append(selected, job)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("unknown project job %q", name)
+		}
+	}
+
+	return selected, nil
+}
+
+// projectJobLabel returns job name, or its schema source when job is unnamed, for error messages.
+func projectJobLabel(job schemadoc.ProjectJob) string {
+	switch {
+	case job.Name != "":
+		return fmt.Sprintf("%q", job.Name)
+	case job.Schema != "":
+		return fmt.Sprintf("%q", job.Schema)
+	default:
+		return fmt.Sprintf("%q", job.Module.Path+"."+job.Module.Type)
+	}
+}
+
+// runProjectJob loads job schema once and generates every job output; it returns number of stale outputs.
+func (runner *cliRunner) runProjectJob(job schemadoc.ProjectJob, projectDir string, check bool) (int, error) {
+	schemaBytes, sourcePath, err := readProjectSchema(job, projectDir)
+	if err != nil {
+		return 0, err
+	}
+
+	runner.warnSchemaDraft(schemaBytes)
+
+	renderOptions, err := projectRenderOptions(job, projectDir, sourcePath)
+	if err != nil {
+		return 0, err
+	}
+
+	stale := 0
+	for _, output := range job.Outputs {
+		drift, err := runner.runProjectOutput(schemaBytes, renderOptions, output, projectDir, check)
+		if err != nil {
+			return 0, fmt.Errorf("output %q: %w", output.Path, err)
+		}
+
+		if drift {
+			stale++
+		}
+	}
+
+	return stale, nil
+}
+
+// readProjectSchema reads job schema file or reflects job module type and returns schema with source marker.
+func readProjectSchema(job schemadoc.ProjectJob, projectDir string) ([]byte, string, error) {
+	if job.Module != nil {
+		schemaBytes, sourcePath, err := generateModuleSchema(moduleSchemaOptions{
+			ModulePath:     job.Module.Path,
+			TypeName:       job.Module.Type,
+			PackagePath:    job.Module.Package,
+			ModuleRootPath: projectFilePath(projectDir, job.Module.Root),
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("generate schema: %w", err)
+		}
+
+		return schemaBytes, sourcePath, nil
+	}
+
+	schemaPath := projectFilePath(projectDir, job.Schema)
+	schemaBytes, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return nil, "", fmt.Errorf("read schema file %q: %w", schemaPath, err)
+	}
+
+	return schemaBytes, schemaPath, nil
+}
+
+// projectRenderOptions resolves job options: source path, base directory, URI mappings and template file.
+func projectRenderOptions(job schemadoc.ProjectJob, projectDir, sourcePath string) (schemadoc.Options, error) {
+	renderOptions := job.Options
+	if strings.TrimSpace(renderOptions.SourcePath) == "" {
+		renderOptions.SourcePath = sourcePath
+	}
+
+	if strings.TrimSpace(renderOptions.BaseDir) == "" {
+		renderOptions.BaseDir = sourceBaseDir(sourcePath)
+	} else {
+		renderOptions.BaseDir = projectFilePath(projectDir, renderOptions.BaseDir)
+	}
+
+	loader, err := resolveSchemaLoader(projectURIMappings(job, projectDir))
+	if err != nil {
+		return schemadoc.Options{}, err
+	}
+
+	renderOptions.Loader = loader
+
+	if job.TemplateFile != "" {
+		templatePath := projectFilePath(projectDir, job.TemplateFile)
+		customTemplate, err := os.ReadFile(templatePath)
+		if err != nil {
+			return schemadoc.Options{}, fmt.Errorf("read template file %q: %w", templatePath, err)
+		}
+
+		renderOptions.TemplateText = string(customTemplate)
+	}
+
+	return renderOptions, nil
+}
+
+// projectURIMappings returns job PREFIX=DIR URI mappings with directories resolved from project file directory.
+func projectURIMappings(job schemadoc.ProjectJob, projectDir string) []string {
+	mappings := make([]string, 0, len(job.MapURI))
+	for _, mapping := range job.MapURI {
+		if prefix, dir, ok := strings.Cut(mapping, "="); ok && strings.TrimSpace(dir) != "" {
+			mapping = prefix + "=" + projectFilePath(projectDir, strings.TrimSpace(dir))
+		}
+
+		mappings = append(mappings, mapping)
+	}
+
+	return mappings
+}
+
+// runProjectOutput generates one job output and writes it, or compares it with existing file in check mode.
+//
+// It reports whether output is out of date; written outputs never are.
+func (runner *cliRunner) runProjectOutput(schemaBytes []byte, jobOptions schemadoc.Options, output schemadoc.ProjectOutput, projectDir string, check bool) (bool, error) {
+	outputPath := projectFilePath(projectDir, output.Path)
+	renderOptions := projectOutputOptions(jobOptions, output)
+
+	if output.Pages {
+		if check {
+			stale, _, err := runner.diffPages(schemaBytes, renderOptions, outputPath)
+			return stale > 0, err
+		}
+
+		return false, runner.writePages(schemaBytes, renderOptions, outputPath)
+	}
+
+	var target []byte
+	if output.Inject != "" {
+		apply := applyMarkdownBlockOptions
+		if output.Format != "markdown" {
+			apply = applyExampleBlockOptions
+		}
+
+		var err error
+		target, err = runner.prepareInjection(output.Inject, outputPath, &renderOptions, apply)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	content, err := renderProjectOutput(schemaBytes, renderOptions, output.Format)
+	if err != nil {
+		return false, err
+	}
+
+	if target != nil {
+		text := string(content)
+		if renderOptions.ExampleFormat != "" && output.Format != "markdown" {
+			text = fencedExample(content, renderOptions.ExampleFormat)
+		}
+
+		content, err = schemadoc.InjectBlock(target, output.Inject, text)
+		if err != nil {
+			return false, fmt.Errorf("inject %s: %w", output.Format, err)
+		}
+	}
+
+	if check {
+		return runner.diffOutputFile(outputPath, string(content))
+	}
+
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0o750); err != nil {
+		return false, fmt.Errorf("create output directory %q: %w", filepath.Dir(outputPath), err)
+	}
+
+	return false, runner.writeOutput(content, output.Format, outputPath)
+}
+
+// projectOutputOptions returns job options adjusted to output format and template of one output.
+//
+// Job template, built-in name or template file, applies to markdown only; other documentation
+// formats use their own built-in template. Output template replaces job template.
+func projectOutputOptions(renderOptions schemadoc.Options, output schemadoc.ProjectOutput) schemadoc.Options {
+	switch output.Format {
+	case "json", "yaml":
+		renderOptions.ExampleFormat = schemadoc.ExampleFormat(output.Format)
+	case "view", "dot":
+	default:
+		renderOptions.Format = schemadoc.OutputFormat(output.Format)
+		if output.Format != "markdown" {
+			renderOptions.TemplateName = ""
+			renderOptions.TemplateText = ""
+		}
+
+		if output.Template != "" {
+			renderOptions.TemplateName = output.Template
+			renderOptions.TemplateText = ""
+		}
+	}
+
+	return renderOptions
+}
+
+// renderProjectOutput renders documentation, example, view model or graph output of format.
+func renderProjectOutput(schemaBytes []byte, renderOptions schemadoc.Options, format string) ([]byte, error) {
+	switch format {
+	case "json", "yaml":
+		content, err := schemadoc.GenerateExampleWithOptions(schemaBytes, renderOptions)
+		if err != nil {
+			return nil, fmt.Errorf("generate %s example: %w", format, err)
+		}
+
+		return content, nil
+	case "view":
+		content, err := schemadoc.BuildViewJSON(schemaBytes, renderOptions)
+		if err != nil {
+			return nil, fmt.Errorf("build view: %w", err)
+		}
+
+		return content, nil
+	case "dot":
+		content, err := schemadoc.RenderDOT(schemaBytes, renderOptions)
+		if err != nil {
+			return nil, fmt.Errorf("render dot: %w", err)
+		}
+
+		return []byte(content), nil
+	default:
+		content, err := schemadoc.Render(schemaBytes, renderOptions)
+		if err != nil {
+			return nil, fmt.Errorf("render %s: %w", format, err)
+		}
+
+		return []byte(content), nil
+	}
+}
+
+// projectFilePath resolves path from project file directory; absolute paths are kept.
+func projectFilePath(projectDir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(projectDir, path)
+}
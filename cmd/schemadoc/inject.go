@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/woozymasta/schemadoc"
+)
+
+var (
+	// errInjectOutputPath is returned when marker injection has no target file.
+	errInjectOutputPath = errors.New("--inject requires output file with marker block")
+	// errInjectOutputDir is returned when marker injection is combined with multi-page output.
+	errInjectOutputDir = errors.New("--inject and --output-dir are mutually exclusive")
+	// errInjectOption is returned when begin marker carries option not supported by command.
+	errInjectOption = errors.New("unsupported marker option")
+)
+
+// prepareInjection reads target file of named marker block and applies its begin marker options.
+//
+// Apply receives render options resolved from flags, so marker options override flags for one block.
+func (runner *cliRunner) prepareInjection(name, outputPath string, renderOptions *schemadoc.Options, apply func(*schemadoc.Options, schemadoc.InjectionBlock) error) ([]byte, error) {
+	outputPath = strings.TrimSpace(outputPath)
+	if outputPath == "" {
+		return nil, errInjectOutputPath
+	}
+
+	target, err := os.ReadFile(outputPath)
+	if err != nil {
+		return nil, fmt.Errorf("read injection target %q: %w", outputPath, err)
+	}
+
+	block, err := schemadoc.FindInjectionBlock(target, name)
+	if err != nil {
+		return nil, fmt.Errorf("injection target %q: %w", outputPath, err)
+	}
+
+	if err := apply(renderOptions, block); err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
+
+// applyMarkdownBlockOptions overrides render options with begin marker options named after long flags.
+//
+// Supported options are `template`, `title`, `heading-offset`, `root`, `property-order`,
+// `mode` and `format` of embedded example.
+func applyMarkdownBlockOptions(renderOptions *schemadoc.Options, block schemadoc.InjectionBlock) error {
+	for _, key := range sortedOptionKeys(block.Options) {
+		value := block.Options[key]
+		switch key {
+		case "template":
+			if value != "list" && value != "table" {
+				return fmt.Errorf("%w %s=%q in block %q: expected list or table", errInjectOption, key, value, block.Name)
+			}
+
+			renderOptions.TemplateName = value
+		case "title":
+			renderOptions.Title = value
+		case "heading-offset":
+			offset, err := strconv.Atoi(value)
+			if err != nil || offset < 0 {
+				return fmt.Errorf("%w %s=%q in block %q: expected non-negative integer", errInjectOption, key, value, block.Name)
+			}
+
+			renderOptions.HeadingOffset = offset
+		case "root":
+			renderOptions.RootDefinition = value
+		case "property-order":
+			renderOptions.PropertyOrder = schemadoc.PropertyOrder(value)
+		case "mode":
+			mode, err := resolveExampleMode(value)
+			if err != nil {
+				return fmt.Errorf("%w in block %q: %w", errInjectOption, block.Name, err)
+			}
+
+			renderOptions.ExampleMode = mode
+		case "format":
+			format, err := resolveExampleFormat(value)
+			if err != nil {
+				return fmt.Errorf("%w in block %q: %w", errInjectOption, block.Name, err)
+			}
+
+			renderOptions.ExampleFormat = format
+			if renderOptions.ExampleMode == "" {
+				renderOptions.ExampleMode = schemadoc.ExampleModeAll
+			}
+		default:
+			return fmt.Errorf("%w %q in block %q", errInjectOption, key, block.Name)
+		}
+	}
+
+	return nil
+}
+
+// applyExampleBlockOptions overrides example options with begin marker options named after long flags.
+//
+// Supported options are `mode` and `property-order`.
+func applyExampleBlockOptions(renderOptions *schemadoc.Options, block schemadoc.InjectionBlock) error {
+	for _, key := range sortedOptionKeys(block.Options) {
+		value := block.Options[key]
+		switch key {
+		case "mode":
+			mode, err := resolveExampleMode(value)
+			if err != nil {
+				return fmt.Errorf("%w in block %q: %w", errInjectOption, block.Name, err)
+			}
+
+			renderOptions.ExampleMode = mode
+		case "property-order":
+			renderOptions.PropertyOrder = schemadoc.PropertyOrder(value)
+		default:
+			return fmt.Errorf("%w %q in block %q", errInjectOption, key, block.Name)
+		}
+	}
+
+	return nil
+}
+
+// sortedOptionKeys returns marker option keys in stable order for deterministic errors.
+func sortedOptionKeys(options map[string]string) []string {
+	keys := make([]string, 0, len(options))
+	for key := range options {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+	return keys
+}
+
+// fencedExample wraps generated example payload into markdown code block tagged with its format.
+func fencedExample(content []byte, format schemadoc.ExampleFormat) string {
+	return "```" + string(format) + "\n" + strings.TrimRight(string(content), "\n") + "\n```\n"
+}
@@ -7,6 +7,7 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -18,6 +19,7 @@ import (
 	"time"
 
 	"github.com/jessevdk/go-flags"
+	"gopkg.in/yaml.v3"
 
 	"github.com/woozymasta/schemadoc"
 )
@@ -46,6 +48,14 @@ type cliOptions struct {
 	Template         templateCommand         `command:"template" description:"Print built-in markdown template"`
 	ModuleToMarkdown moduleToMarkdownCommand `command:"mod2md" description:"Generate markdown from Go module type"`
 	SchemaToMarkdown schemaToMarkdownCommand `command:"schema2md" description:"Convert JSON Schema to markdown"`
+	SchemaToHTML     schemaToHTMLCommand     `command:"schema2html" description:"Convert JSON Schema to standalone HTML page"`
+	SchemaToAsciiDoc schemaToAsciiDocCommand `command:"schema2adoc" description:"Convert JSON Schema to AsciiDoc"`
+	SchemaToRST      schemaToRSTCommand      `command:"schema2rst" description:"Convert JSON Schema to reStructuredText"`
+	SchemaToMan      schemaToManCommand      `command:"schema2man" description:"Convert JSON Schema to roff man page"`
+	SchemaToView     schemaToViewCommand     `command:"schema2view" description:"Export documentation view model of JSON Schema as JSON"`
+	SchemaToDOT      schemaToDOTCommand      `command:"schema2dot" description:"Convert JSON Schema definition graph to Graphviz DOT"`
+	Bundle           bundleCommand           `command:"bundle" description:"Merge externally referenced schema files into one schema"`
+	Generate         generateCommand         `command:"generate" description:"Run generation jobs of schemadoc.yaml or schemadoc.json project file"`
 }
 
 // moduleReflectFlags groups common module reflection flags.
@@ -57,10 +67,34 @@ type moduleReflectFlags struct {
 
 // markdownRenderFlags groups markdown rendering flags.
 type markdownRenderFlags struct {
-	TemplatePath string `short:"f" long:"template-file" description:"Path to custom markdown template (.gotmpl)"`
-	Title        string `short:"T" long:"title" description:"Markdown document title" default:"schema reference"`
-	ListMarker   string `short:"l" long:"list-marker" description:"Unordered list marker for normalized descriptions" choice:"-" choice:"*" default:"*"`
-	WrapWidth    int    `short:"w" long:"wrap" description:"Wrap width for plain text descriptions" default:"80"`
+	TemplatePath  string `short:"f" long:"template-file" description:"Path to custom markdown template (.gotmpl)"`
+	Title         string `short:"T" long:"title" description:"Markdown document title" default:"schema reference"`
+	ListMarker    string `short:"l" long:"list-marker" description:"Unordered list marker for normalized descriptions" choice:"-" choice:"*" default:"*"`
+	Root          string `long:"root" description:"Definition rendered first and used as origin of property paths"`
+	PropertyOrder string `short:"o" long:"property-order" description:"Property order in sections and embedded example" choice:"source" choice:"required-first" choice:"alphabetical" choice:"required-sorted" default:"source"`
+	WrapWidth     int    `short:"w" long:"wrap" description:"Wrap width for plain text descriptions" default:"80"`
+	NestedDepth   int    `short:"d" long:"nested-depth" description:"Max depth of inline nested object properties (negative disables)" default:"5"`
+	DiagramDepth  int    `long:"diagram-depth" description:"Max reference hops from root in mermaid template diagrams (zero shows all)" default:"0"`
+	HeadingOffset int    `long:"heading-offset" description:"Shift markdown headings down by levels, for embedding under existing headings" default:"0"`
+	SettingsIndex bool   `long:"settings-index" description:"Add \"All settings\" table of every leaf property path"`
+}
+
+// viewRenderFlags groups view model flags shared with markdown rendering, without template selection.
+type viewRenderFlags struct {
+	Title         string `short:"T" long:"title" description:"Document title" default:"schema reference"`
+	ListMarker    string `short:"l" long:"list-marker" description:"Unordered list marker for normalized descriptions" choice:"-" choice:"*" default:"*"`
+	Root          string `long:"root" description:"Definition rendered first and used as origin of property paths"`
+	PropertyOrder string `short:"o" long:"property-order" description:"Property order in sections and embedded example" choice:"source" choice:"required-first" choice:"alphabetical" choice:"required-sorted" default:"source"`
+	WrapWidth     int    `short:"w" long:"wrap" description:"Wrap width for plain text descriptions" default:"80"`
+	NestedDepth   int    `short:"d" long:"nested-depth" description:"Max depth of inline nested object properties (negative disables)" default:"5"`
+	SettingsIndex bool   `long:"settings-index" description:"Add index of every leaf property path"`
+}
+
+// diagramFlags groups definition graph diagram flags.
+type diagramFlags struct {
+	Title        string `short:"T" long:"title" description:"Graph label" default:"schema reference"`
+	Root         string `long:"root" description:"Definition used as graph root"`
+	DiagramDepth int    `long:"diagram-depth" description:"Max reference hops from root definition (zero shows all reachable definitions)" default:"0"`
 }
 
 // templateSelectFlags groups built-in template selection flags.
@@ -68,9 +102,53 @@ type templateSelectFlags struct {
 	TemplateName string `short:"t" long:"template" description:"Built-in template style" choice:"list" choice:"table" default:"list"`
 }
 
+// templateExportFlags groups built-in template export flags.
+type templateExportFlags struct {
+	TemplateName string `short:"t" long:"template" description:"Built-in template name" choice:"list" choice:"table" choice:"html" choice:"asciidoc" choice:"rst" choice:"man" default:"list"`
+}
+
+// manPageFlags groups man page header flags.
+type manPageFlags struct {
+	Section string `short:"s" long:"section" description:"Man page section" default:"5"`
+	Date    string `long:"date" description:"Man page date in header (empty keeps output reproducible)"`
+	Source  string `long:"source" description:"Man page source in header, usually project name and version"`
+}
+
+// pagesFlags groups multi-page markdown output flags.
+type pagesFlags struct {
+	OutputDir string `short:"D" long:"output-dir" description:"Write index page and one page per definition into directory instead of single output"`
+	PageName  string `long:"page-name" description:"File name pattern of definition pages; {slug} and {name} are replaced with definition slug and name" default:"{slug}.md"`
+	IndexPage string `long:"index-page" description:"File name of index page in output directory" default:"index.md"`
+}
+
+// definitionFilterFlags groups flags selecting documented definitions.
+type definitionFilterFlags struct {
+	Include     []string `long:"include" description:"Document only definitions matching glob, root definition is always kept (repeatable)"`
+	Exclude     []string `long:"exclude" description:"Leave out definitions matching glob (repeatable)"`
+	DropOrphans bool     `long:"drop-orphans" description:"Leave out definitions not referenced from root definition"`
+}
+
+// checkFlags groups drift check flags of generated output.
+type checkFlags struct {
+	Check bool `long:"check" description:"Compare generated output with existing output file instead of writing; print unified diff and fail on drift"`
+}
+
+// injectFlags groups marker block injection flags of generated output.
+type injectFlags struct {
+	Block string `long:"inject" value-name:"NAME" description:"Replace content between <!-- schemadoc:begin NAME --> and <!-- schemadoc:end NAME --> markers of output file instead of overwriting it"`
+}
+
+// watchFlags groups flags regenerating output on change of input files.
+type watchFlags struct {
+	Watch    bool          `long:"watch" description:"Keep running and regenerate output when input schema, referenced schema files or template file change"`
+	Interval time.Duration `long:"watch-interval" value-name:"DURATION" description:"Polling interval of watched files" default:"500ms"`
+	Debounce time.Duration `long:"watch-debounce" value-name:"DURATION" description:"Quiet period after last change before regeneration" default:"200ms"`
+}
+
 // exampleModeFlags groups example mode flags.
 type exampleModeFlags struct {
-	Mode string `short:"m" long:"mode" description:"Example generation mode" choice:"all" choice:"required" default:"all"`
+	Mode          string `short:"m" long:"mode" description:"Example generation mode" choice:"all" choice:"required" default:"all"`
+	PropertyOrder string `short:"o" long:"property-order" description:"Example key order" choice:"source" choice:"required-first" choice:"alphabetical" choice:"required-sorted" default:"source"`
 }
 
 // markdownExampleFlags groups embedded example mode and format flags.
@@ -79,6 +157,76 @@ type markdownExampleFlags struct {
 	Format string `short:"F" long:"format" description:"Embedded example format for markdown output (empty disables embedding)" choice:"json" choice:"yaml"`
 }
 
+// referenceFlags groups external schema reference resolution flags.
+type referenceFlags struct {
+	URIMappings []string `short:"M" long:"map-uri" description:"Map absolute $ref URI prefix to local directory as PREFIX=DIR (repeatable)"`
+}
+
+// markdownOptions groups schema-to-markdown settings collected from command flags.
+type markdownOptions struct {
+	Format         schemadoc.OutputFormat
+	ManPage        manPageFlags
+	Pages          pagesFlags
+	Filter         definitionFilterFlags
+	Watch          watchFlags
+	TemplateName   string
+	Title          string
+	TemplatePath   string
+	ListMarker     string
+	Inject         string
+	ExampleMode    string
+	ExampleFormat  string
+	URIMappings    []string
+	RootDefinition string
+	PropertyOrder  string
+	WrapWidth      int
+	NestedDepth    int
+	DiagramDepth   int
+	HeadingOffset  int
+	SettingsIndex  bool
+	Check          bool
+}
+
+// newMarkdownOptions collects markdown rendering settings from shared flag groups.
+func newMarkdownOptions(templateFlags templateSelectFlags, renderFlags markdownRenderFlags, exampleFlags markdownExampleFlags, refFlags referenceFlags) markdownOptions {
+	return markdownOptions{
+		TemplateName:   templateFlags.TemplateName,
+		Title:          renderFlags.Title,
+		TemplatePath:   renderFlags.TemplatePath,
+		WrapWidth:      renderFlags.WrapWidth,
+		NestedDepth:    renderFlags.NestedDepth,
+		DiagramDepth:   renderFlags.DiagramDepth,
+		HeadingOffset:  renderFlags.HeadingOffset,
+		SettingsIndex:  renderFlags.SettingsIndex,
+		RootDefinition: renderFlags.Root,
+		PropertyOrder:  renderFlags.PropertyOrder,
+		ListMarker:     renderFlags.ListMarker,
+		ExampleMode:    exampleFlags.Mode,
+		ExampleFormat:  exampleFlags.Format,
+		URIMappings:    refFlags.URIMappings,
+	}
+}
+
+// exampleOptions is the resolved set of example generation settings shared by schema2json and schema2yaml.
+type exampleOptions struct {
+	Mode          string
+	Format        string
+	PropertyOrder string
+	Inject        string
+	URIMappings   []string
+	Check         bool
+}
+
+// newExampleOptions collects example generation settings from shared flag groups.
+func newExampleOptions(exampleFlags exampleModeFlags, format schemadoc.ExampleFormat, refFlags referenceFlags) exampleOptions {
+	return exampleOptions{
+		Mode:          exampleFlags.Mode,
+		Format:        string(format),
+		PropertyOrder: exampleFlags.PropertyOrder,
+		URIMappings:   refFlags.URIMappings,
+	}
+}
+
 // moduleToMarkdownCommand wraps module-to-schema and schema-to-markdown flows.
 type moduleToMarkdownCommand struct {
 	runner *cliRunner
@@ -89,13 +237,23 @@ type moduleToMarkdownCommand struct {
 		Output string `positional-arg-name:"output" description:"Output markdown file path (optional; stdout when omitted)"`
 	} `positional-args:"yes"`
 
-	ExampleFlags  markdownExampleFlags `group:"Embedded Example"`
-	TemplateFlags templateSelectFlags  `group:"Template Select"`
-	RenderFlags   markdownRenderFlags  `group:"Markdown Render"`
+	ExampleFlags  markdownExampleFlags  `group:"Embedded Example"`
+	TemplateFlags templateSelectFlags   `group:"Template Select"`
+	RenderFlags   markdownRenderFlags   `group:"Markdown Render"`
+	FilterFlags   definitionFilterFlags `group:"Definition Filter"`
+	PagesFlags    pagesFlags            `group:"Multi-page Output"`
+	InjectFlags   injectFlags           `group:"Marker Injection"`
+	CheckFlags    checkFlags            `group:"Drift Check"`
 }
 
 // Execute runs mod2md subcommand.
 func (command *moduleToMarkdownCommand) Execute(_ []string) error {
+	options := newMarkdownOptions(command.TemplateFlags, command.RenderFlags, command.ExampleFlags, referenceFlags{})
+	options.Pages = command.PagesFlags
+	options.Filter = command.FilterFlags
+	options.Inject = command.InjectFlags.Block
+	options.Check = command.CheckFlags.Check
+
 	return command.runner.runModuleToMarkdown(
 		moduleSchemaOptions{
 			ModulePath:     command.Args.Module,
@@ -103,13 +261,7 @@ func (command *moduleToMarkdownCommand) Execute(_ []string) error {
 			PackagePath:    command.ModuleFlags.PackagePath,
 			ModuleRootPath: command.ModuleFlags.ModuleRootPath,
 		},
-		command.TemplateFlags.TemplateName,
-		command.RenderFlags.Title,
-		command.RenderFlags.TemplatePath,
-		command.RenderFlags.WrapWidth,
-		command.RenderFlags.ListMarker,
-		command.ExampleFlags.Mode,
-		command.ExampleFlags.Format,
+		options,
 		command.Args.Output,
 	)
 }
@@ -143,21 +295,182 @@ type schemaToMarkdownCommand struct {
 		Output string `positional-arg-name:"output" description:"Output markdown file path (optional; stdout when omitted)"`
 	} `positional-args:"yes"`
 
-	ExampleFlags  markdownExampleFlags `group:"Embedded Example"`
-	TemplateFlags templateSelectFlags  `group:"Template Select"`
-	RenderFlags   markdownRenderFlags  `group:"Markdown Render"`
+	ExampleFlags   markdownExampleFlags  `group:"Embedded Example"`
+	TemplateFlags  templateSelectFlags   `group:"Template Select"`
+	RenderFlags    markdownRenderFlags   `group:"Markdown Render"`
+	FilterFlags    definitionFilterFlags `group:"Definition Filter"`
+	PagesFlags     pagesFlags            `group:"Multi-page Output"`
+	ReferenceFlags referenceFlags        `group:"Schema References"`
+	InjectFlags    injectFlags           `group:"Marker Injection"`
+	CheckFlags     checkFlags            `group:"Drift Check"`
+	WatchFlags     watchFlags            `group:"Watch"`
 }
 
 // Execute runs schemadoc subcommand.
 func (command *schemaToMarkdownCommand) Execute(_ []string) error {
-	return command.runner.runSchemaToMarkdown(
-		command.TemplateFlags.TemplateName,
-		command.RenderFlags.Title,
-		command.RenderFlags.TemplatePath,
-		command.RenderFlags.WrapWidth,
-		command.RenderFlags.ListMarker,
-		command.ExampleFlags.Mode,
-		command.ExampleFlags.Format,
+	if command.WatchFlags.Watch && command.CheckFlags.Check {
+		return errWatchCheck
+	}
+
+	options := newMarkdownOptions(command.TemplateFlags, command.RenderFlags, command.ExampleFlags, command.ReferenceFlags)
+	options.Pages = command.PagesFlags
+	options.Filter = command.FilterFlags
+	options.Inject = command.InjectFlags.Block
+	options.Check = command.CheckFlags.Check
+	options.Watch = command.WatchFlags
+
+	return command.runner.runSchemaToMarkdown(options, command.Args.Input, command.Args.Output)
+}
+
+// schemaToHTMLCommand converts schema JSON to standalone HTML page.
+type schemaToHTMLCommand struct {
+	runner *cliRunner
+	Args   struct {
+		Input  string `positional-arg-name:"input" description:"Input schema file path (optional; stdin when omitted)"`
+		Output string `positional-arg-name:"output" description:"Output html file path (optional; stdout when omitted)"`
+	} `positional-args:"yes"`
+
+	ExampleFlags   markdownExampleFlags  `group:"Embedded Example"`
+	RenderFlags    markdownRenderFlags   `group:"Render"`
+	FilterFlags    definitionFilterFlags `group:"Definition Filter"`
+	ReferenceFlags referenceFlags        `group:"Schema References"`
+}
+
+// Execute runs schema2html subcommand.
+func (command *schemaToHTMLCommand) Execute(_ []string) error {
+	options := newMarkdownOptions(templateSelectFlags{}, command.RenderFlags, command.ExampleFlags, command.ReferenceFlags)
+	options.Format = schemadoc.OutputFormatHTML
+	options.Filter = command.FilterFlags
+
+	return command.runner.runSchemaToMarkdown(options, command.Args.Input, command.Args.Output)
+}
+
+// schemaToAsciiDocCommand converts schema JSON to AsciiDoc.
+type schemaToAsciiDocCommand struct {
+	runner *cliRunner
+	Args   struct {
+		Input  string `positional-arg-name:"input" description:"Input schema file path (optional; stdin when omitted)"`
+		Output string `positional-arg-name:"output" description:"Output adoc file path (optional; stdout when omitted)"`
+	} `positional-args:"yes"`
+
+	ExampleFlags   markdownExampleFlags  `group:"Embedded Example"`
+	RenderFlags    markdownRenderFlags   `group:"Render"`
+	FilterFlags    definitionFilterFlags `group:"Definition Filter"`
+	ReferenceFlags referenceFlags        `group:"Schema References"`
+}
+
+// Execute runs schema2adoc subcommand.
+func (command *schemaToAsciiDocCommand) Execute(_ []string) error {
+	options := newMarkdownOptions(templateSelectFlags{}, command.RenderFlags, command.ExampleFlags, command.ReferenceFlags)
+	options.Format = schemadoc.OutputFormatAsciiDoc
+	options.Filter = command.FilterFlags
+
+	return command.runner.runSchemaToMarkdown(options, command.Args.Input, command.Args.Output)
+}
+
+// schemaToRSTCommand converts schema JSON to reStructuredText.
+type schemaToRSTCommand struct {
+	runner *cliRunner
+	Args   struct {
+		Input  string `positional-arg-name:"input" description:"Input schema file path (optional; stdin when omitted)"`
+		Output string `positional-arg-name:"output" description:"Output rst file path (optional; stdout when omitted)"`
+	} `positional-args:"yes"`
+
+	ExampleFlags   markdownExampleFlags  `group:"Embedded Example"`
+	RenderFlags    markdownRenderFlags   `group:"Render"`
+	FilterFlags    definitionFilterFlags `group:"Definition Filter"`
+	ReferenceFlags referenceFlags        `group:"Schema References"`
+}
+
+// Execute runs schema2rst subcommand.
+func (command *schemaToRSTCommand) Execute(_ []string) error {
+	options := newMarkdownOptions(templateSelectFlags{}, command.RenderFlags, command.ExampleFlags, command.ReferenceFlags)
+	options.Format = schemadoc.OutputFormatRST
+	options.Filter = command.FilterFlags
+
+	return command.runner.runSchemaToMarkdown(options, command.Args.Input, command.Args.Output)
+}
+
+// schemaToManCommand converts schema JSON to roff man page.
+type schemaToManCommand struct {
+	runner *cliRunner
+	Args   struct {
+		Input  string `positional-arg-name:"input" description:"Input schema file path (optional; stdin when omitted)"`
+		Output string `positional-arg-name:"output" description:"Output man page file path (optional; stdout when omitted)"`
+	} `positional-args:"yes"`
+
+	ExampleFlags   markdownExampleFlags  `group:"Embedded Example"`
+	RenderFlags    markdownRenderFlags   `group:"Render"`
+	FilterFlags    definitionFilterFlags `group:"Definition Filter"`
+	ManFlags       manPageFlags          `group:"Man Page"`
+	ReferenceFlags referenceFlags        `group:"Schema References"`
+}
+
+// Execute runs schema2man subcommand.
+func (command *schemaToManCommand) Execute(_ []string) error {
+	options := newMarkdownOptions(templateSelectFlags{}, command.RenderFlags, command.ExampleFlags, command.ReferenceFlags)
+	options.Format = schemadoc.OutputFormatMan
+	options.Filter = command.FilterFlags
+	options.ManPage = command.ManFlags
+
+	return command.runner.runSchemaToMarkdown(options, command.Args.Input, command.Args.Output)
+}
+
+// schemaToViewCommand exports documentation view model of schema as JSON.
+type schemaToViewCommand struct {
+	runner *cliRunner
+	Args   struct {
+		Input  string `positional-arg-name:"input" description:"Input schema file path (optional; stdin when omitted)"`
+		Output string `positional-arg-name:"output" description:"Output json file path (optional; stdout when omitted)"`
+	} `positional-args:"yes"`
+
+	ExampleFlags   markdownExampleFlags  `group:"Embedded Example"`
+	RenderFlags    viewRenderFlags       `group:"View"`
+	FilterFlags    definitionFilterFlags `group:"Definition Filter"`
+	ReferenceFlags referenceFlags        `group:"Schema References"`
+}
+
+// Execute runs schema2view subcommand.
+func (command *schemaToViewCommand) Execute(_ []string) error {
+	renderFlags := markdownRenderFlags{
+		Title:         command.RenderFlags.Title,
+		ListMarker:    command.RenderFlags.ListMarker,
+		WrapWidth:     command.RenderFlags.WrapWidth,
+		NestedDepth:   command.RenderFlags.NestedDepth,
+		Root:          command.RenderFlags.Root,
+		PropertyOrder: command.RenderFlags.PropertyOrder,
+		SettingsIndex: command.RenderFlags.SettingsIndex,
+	}
+
+	options := newMarkdownOptions(templateSelectFlags{}, renderFlags, command.ExampleFlags, command.ReferenceFlags)
+	options.Filter = command.FilterFlags
+
+	return command.runner.runSchemaToView(options, command.Args.Input, command.Args.Output)
+}
+
+// schemaToDOTCommand converts schema definition graph to Graphviz DOT.
+type schemaToDOTCommand struct {
+	runner *cliRunner
+	Args   struct {
+		Input  string `positional-arg-name:"input" description:"Input schema file path (optional; stdin when omitted)"`
+		Output string `positional-arg-name:"output" description:"Output dot file path (optional; stdout when omitted)"`
+	} `positional-args:"yes"`
+
+	DiagramFlags   diagramFlags          `group:"Diagram"`
+	FilterFlags    definitionFilterFlags `group:"Definition Filter"`
+	ReferenceFlags referenceFlags        `group:"Schema References"`
+}
+
+// Execute runs schema2dot subcommand.
+func (command *schemaToDOTCommand) Execute(_ []string) error {
+	return command.runner.runSchemaToDOT(
+		markdownOptions{
+			Title:          command.DiagramFlags.Title,
+			RootDefinition: command.DiagramFlags.Root,
+			DiagramDepth:   command.DiagramFlags.DiagramDepth,
+			URIMappings:    command.ReferenceFlags.URIMappings,
+			Filter:         command.FilterFlags,
+		},
 		command.Args.Input,
 		command.Args.Output,
 	)
@@ -171,17 +484,19 @@ type schemaToJSONCommand struct {
 		Output string `positional-arg-name:"output" description:"Output json file path (optional; stdout when omitted)"`
 	} `positional-args:"yes"`
 
-	ExampleFlags exampleModeFlags `group:"Example Generate"`
+	ExampleFlags   exampleModeFlags `group:"Example Generate"`
+	ReferenceFlags referenceFlags   `group:"Schema References"`
+	InjectFlags    injectFlags      `group:"Marker Injection"`
+	CheckFlags     checkFlags       `group:"Drift Check"`
 }
 
 // Execute runs schema2json subcommand.
 func (command *schemaToJSONCommand) Execute(_ []string) error {
-	return command.runner.runSchemaToExample(
-		command.ExampleFlags.Mode,
-		string(schemadoc.ExampleFormatJSON),
-		command.Args.Input,
-		command.Args.Output,
-	)
+	options := newExampleOptions(command.ExampleFlags, schemadoc.ExampleFormatJSON, command.ReferenceFlags)
+	options.Inject = command.InjectFlags.Block
+	options.Check = command.CheckFlags.Check
+
+	return command.runner.runSchemaToExample(options, command.Args.Input, command.Args.Output)
 }
 
 // schemaToYAMLCommand generates example YAML payload from schema.
@@ -192,17 +507,60 @@ type schemaToYAMLCommand struct {
 		Output string `positional-arg-name:"output" description:"Output yaml file path (optional; stdout when omitted)"`
 	} `positional-args:"yes"`
 
-	ExampleFlags exampleModeFlags `group:"Example Generate"`
+	ExampleFlags   exampleModeFlags `group:"Example Generate"`
+	ReferenceFlags referenceFlags   `group:"Schema References"`
+	InjectFlags    injectFlags      `group:"Marker Injection"`
+	CheckFlags     checkFlags       `group:"Drift Check"`
 }
 
 // Execute runs schema2yaml subcommand.
 func (command *schemaToYAMLCommand) Execute(_ []string) error {
-	return command.runner.runSchemaToExample(
-		command.ExampleFlags.Mode,
-		string(schemadoc.ExampleFormatYAML),
-		command.Args.Input,
-		command.Args.Output,
-	)
+	options := newExampleOptions(command.ExampleFlags, schemadoc.ExampleFormatYAML, command.ReferenceFlags)
+	options.Inject = command.InjectFlags.Block
+	options.Check = command.CheckFlags.Check
+
+	return command.runner.runSchemaToExample(options, command.Args.Input, command.Args.Output)
+}
+
+// bundleCommand merges external file references into one schema document.
+type bundleCommand struct {
+	runner *cliRunner
+	Args   struct {
+		Input  string `positional-arg-name:"input" description:"Input schema file path (optional; stdin when omitted)"`
+		Output string `positional-arg-name:"output" description:"Output schema file path (optional; stdout when omitted)"`
+	} `positional-args:"yes"`
+
+	ReferenceFlags referenceFlags `group:"Schema References"`
+}
+
+// Execute runs bundle subcommand.
+func (command *bundleCommand) Execute(_ []string) error {
+	return command.runner.runBundle(command.ReferenceFlags.URIMappings, command.Args.Input, command.Args.Output)
+}
+
+// generateCommand runs generation jobs listed in project file.
+type generateCommand struct {
+	runner *cliRunner
+	Args   struct {
+		Project string `positional-arg-name:"project" description:"Project file path (optional; schemadoc.yaml, schemadoc.yml or schemadoc.json in current directory when omitted)"`
+	} `positional-args:"yes"`
+
+	Jobs       []string   `short:"j" long:"job" description:"Run only named job (repeatable)"`
+	CheckFlags checkFlags `group:"Drift Check"`
+	WatchFlags watchFlags `group:"Watch"`
+}
+
+// Execute runs generate subcommand.
+func (command *generateCommand) Execute(_ []string) error {
+	if command.WatchFlags.Watch {
+		if command.CheckFlags.Check {
+			return errWatchCheck
+		}
+
+		return command.runner.watchGenerate(command.Args.Project, command.Jobs, command.WatchFlags)
+	}
+
+	return command.runner.runGenerate(command.Args.Project, command.Jobs, command.CheckFlags.Check)
 }
 
 // templateCommand exports built-in markdown template.
@@ -212,7 +570,7 @@ type templateCommand struct {
 		Output string `positional-arg-name:"output" description:"Output template file path (optional; stdout when omitted)"`
 	} `positional-args:"yes"`
 
-	TemplateFlags templateSelectFlags `group:"Template Select"`
+	TemplateFlags templateExportFlags `group:"Template Select"`
 }
 
 // Execute runs template subcommand.
@@ -222,10 +580,12 @@ func (command *templateCommand) Execute(_ []string) error {
 
 // cliRunner executes CLI operations with custom IO streams.
 type cliRunner struct {
-	stdin       io.Reader
-	stdout      io.Writer
-	stderr      io.Writer
-	programName string
+	// watchContext stops watch loops; nil context stops them on interrupt signal.
+	watchContext context.Context
+	stdin        io.Reader
+	stdout       io.Writer
+	stderr       io.Writer
+	programName  string
 }
 
 // versionCommand prints version information.
@@ -308,13 +668,13 @@ func (runner *cliRunner) run(args []string) int {
 }
 
 // runModuleToMarkdown executes module-to-markdown flow without temporary schema files.
-func (runner *cliRunner) runModuleToMarkdown(moduleOptions moduleSchemaOptions, templateName, title, templatePath string, wrapWidth int, listMarker, exampleMode, exampleFormat, outputPath string) error {
+func (runner *cliRunner) runModuleToMarkdown(moduleOptions moduleSchemaOptions, options markdownOptions, outputPath string) error {
 	schemaBytes, sourcePath, err := generateModuleSchema(moduleOptions)
 	if err != nil {
 		return fmt.Errorf("generate schema: %w", err)
 	}
 
-	return runner.runSchemaToMarkdownBytes(templateName, title, templatePath, wrapWidth, listMarker, exampleMode, exampleFormat, schemaBytes, sourcePath, outputPath)
+	return runner.runSchemaToMarkdownBytes(options, schemaBytes, sourcePath, outputPath)
 }
 
 // runModuleToSchema executes module-to-schema flow and writes result to stdout or file.
@@ -340,107 +700,301 @@ func (runner *cliRunner) runModuleToSchema(moduleOptions moduleSchemaOptions, ou
 }
 
 // runSchemaToMarkdown executes schema-to-markdown flow and writes result to stdout or file.
-func (runner *cliRunner) runSchemaToMarkdown(templateName, title, templatePath string, wrapWidth int, listMarker, exampleMode, exampleFormat, inputPath, outputPath string) error {
+func (runner *cliRunner) runSchemaToMarkdown(options markdownOptions, inputPath, outputPath string) error {
+	if options.Watch.Watch {
+		return runner.watchSchemaToMarkdown(options, inputPath, outputPath)
+	}
+
 	schemaBytes, sourcePath, err := runner.readSchemaInput(inputPath)
 	if err != nil {
 		return fmt.Errorf("read schema input: %w", err)
 	}
 
-	return runner.runSchemaToMarkdownBytes(templateName, title, templatePath, wrapWidth, listMarker, exampleMode, exampleFormat, schemaBytes, sourcePath, outputPath)
+	return runner.runSchemaToMarkdownBytes(options, schemaBytes, sourcePath, outputPath)
 }
 
 // runSchemaToExample generates example payload for selected mode and format.
-func (runner *cliRunner) runSchemaToExample(mode, format, inputPath, outputPath string) error {
-	schemaBytes, _, err := runner.readSchemaInput(inputPath)
+func (runner *cliRunner) runSchemaToExample(options exampleOptions, inputPath, outputPath string) error {
+	schemaBytes, sourcePath, err := runner.readSchemaInput(inputPath)
 	if err != nil {
 		return fmt.Errorf("read schema input: %w", err)
 	}
 
-	selectedMode, err := resolveExampleMode(mode)
+	selectedMode, err := resolveExampleMode(options.Mode)
 	if err != nil {
 		return err
 	}
 
-	selectedFormat, err := resolveExampleFormat(format)
+	selectedFormat, err := resolveExampleFormat(options.Format)
 	if err != nil {
 		return err
 	}
 
-	content, err := schemadoc.GenerateExample(schemaBytes, selectedMode, selectedFormat)
+	loader, err := resolveSchemaLoader(options.URIMappings)
 	if err != nil {
-		return fmt.Errorf("generate %s %s example: %w", selectedMode, selectedFormat, err)
+		return err
 	}
 
-	outputPath = strings.TrimSpace(outputPath)
-	if outputPath == "" {
-		if _, err := runner.stdout.Write(content); err != nil {
-			return fmt.Errorf("write example to stdout: %w", err)
+	renderOptions := schemadoc.Options{
+		SourcePath:    sourcePath,
+		BaseDir:       sourceBaseDir(sourcePath),
+		Loader:        loader,
+		ExampleMode:   selectedMode,
+		ExampleFormat: selectedFormat,
+		PropertyOrder: schemadoc.PropertyOrder(options.PropertyOrder),
+	}
+
+	var target []byte
+	if options.Inject != "" {
+		target, err = runner.prepareInjection(options.Inject, outputPath, &renderOptions, applyExampleBlockOptions)
+		if err != nil {
+			return err
 		}
+	}
 
-		return nil
+	content, err := schemadoc.GenerateExampleWithOptions(schemaBytes, renderOptions)
+	if err != nil {
+		return fmt.Errorf("generate %s %s example: %w", renderOptions.ExampleMode, selectedFormat, err)
 	}
 
-	if err := os.WriteFile(outputPath, content, 0o600); err != nil {
-		return fmt.Errorf("write example file %q: %w", outputPath, err)
+	if target != nil {
+		content, err = schemadoc.InjectBlock(target, options.Inject, fencedExample(content, selectedFormat))
+		if err != nil {
+			return fmt.Errorf("inject example: %w", err)
+		}
 	}
 
-	return nil
+	if options.Check {
+		return runner.checkOutput(content, "example", outputPath)
+	}
+
+	return runner.writeOutput(content, "example", outputPath)
 }
 
 // runSchemaToMarkdownBytes renders markdown from schema bytes and writes result to stdout or file.
-func (runner *cliRunner) runSchemaToMarkdownBytes(templateName, title, templatePath string, wrapWidth int, listMarker, exampleMode, exampleFormat string, schemaBytes []byte, sourcePath, outputPath string) error {
-	draftURI := extractSchemaDraftURI(schemaBytes)
-	draft := schemadoc.DetectDraft(draftURI)
-	if strings.TrimSpace(draftURI) == "" {
-		_, _ = fmt.Fprintln(runner.stderr, "warning: schema has no $schema value; draft support is unknown")
-	} else if !draft.Supported {
-		_, _ = fmt.Fprintf(runner.stderr, "warning: unsupported $schema value %q\n", draftURI)
+//
+// With marker injection, output file is target document and only its marker block is replaced.
+func (runner *cliRunner) runSchemaToMarkdownBytes(options markdownOptions, schemaBytes []byte, sourcePath, outputPath string) error {
+	renderOptions, err := runner.resolveRenderOptions(options, schemaBytes, sourcePath)
+	if err != nil {
+		return err
+	}
+
+	var target []byte
+	if options.Inject != "" {
+		if strings.TrimSpace(options.Pages.OutputDir) != "" {
+			return errInjectOutputDir
+		}
+
+		target, err = runner.prepareInjection(options.Inject, outputPath, &renderOptions, applyMarkdownBlockOptions)
+		if err != nil {
+			return err
+		}
+	}
+
+	formatName := "markdown"
+	if options.Format != "" {
+		formatName = string(options.Format)
+	}
+
+	if outputDir := strings.TrimSpace(options.Pages.OutputDir); outputDir != "" {
+		if strings.TrimSpace(outputPath) != "" {
+			return errors.New("output file and --output-dir are mutually exclusive")
+		}
+
+		if options.Check {
+			return runner.checkPages(schemaBytes, renderOptions, outputDir)
+		}
+
+		return runner.writePages(schemaBytes, renderOptions, outputDir)
+	}
+
+	rendered, err := schemadoc.Render(schemaBytes, renderOptions)
+	if err != nil {
+		return fmt.Errorf("render %s: %w", formatName, err)
+	}
+
+	content := []byte(rendered)
+	if target != nil {
+		content, err = schemadoc.InjectBlock(target, options.Inject, rendered)
+		if err != nil {
+			return fmt.Errorf("inject %s: %w", formatName, err)
+		}
+	}
+
+	if options.Check {
+		return runner.checkOutput(content, formatName, outputPath)
+	}
+
+	return runner.writeOutput(content, formatName, outputPath)
+}
+
+// runSchemaToView builds documentation view model and writes it as JSON to stdout or file.
+func (runner *cliRunner) runSchemaToView(options markdownOptions, inputPath, outputPath string) error {
+	schemaBytes, sourcePath, err := runner.readSchemaInput(inputPath)
+	if err != nil {
+		return fmt.Errorf("read schema input: %w", err)
 	}
 
-	mode, format, err := resolveMarkdownExampleOptions(exampleMode, exampleFormat)
+	renderOptions, err := runner.resolveRenderOptions(options, schemaBytes, sourcePath)
 	if err != nil {
 		return err
 	}
 
-	renderOptions := schemadoc.Options{
-		Title:         title,
-		SourcePath:    sourcePath,
-		TemplateName:  templateName,
-		WrapWidth:     wrapWidth,
-		ListMarker:    listMarker,
-		ExampleMode:   mode,
-		ExampleFormat: format,
+	content, err := schemadoc.BuildViewJSON(schemaBytes, renderOptions)
+	if err != nil {
+		return fmt.Errorf("build view: %w", err)
+	}
+
+	return runner.writeOutput(content, "view", outputPath)
+}
+
+// runSchemaToDOT renders definition graph of schema as Graphviz DOT and writes it to stdout or file.
+func (runner *cliRunner) runSchemaToDOT(options markdownOptions, inputPath, outputPath string) error {
+	schemaBytes, sourcePath, err := runner.readSchemaInput(inputPath)
+	if err != nil {
+		return fmt.Errorf("read schema input: %w", err)
+	}
+
+	renderOptions, err := runner.resolveRenderOptions(options, schemaBytes, sourcePath)
+	if err != nil {
+		return err
+	}
+
+	content, err := schemadoc.RenderDOT(schemaBytes, renderOptions)
+	if err != nil {
+		return fmt.Errorf("render dot: %w", err)
+	}
+
+	return runner.writeOutput([]byte(content), "dot", outputPath)
+}
+
+// resolveRenderOptions converts collected flags into package render options and warns about unknown drafts.
+func (runner *cliRunner) resolveRenderOptions(options markdownOptions, schemaBytes []byte, sourcePath string) (schemadoc.Options, error) {
+	runner.warnSchemaDraft(schemaBytes)
+
+	mode, format, err := resolveMarkdownExampleOptions(options.ExampleMode, options.ExampleFormat)
+	if err != nil {
+		return schemadoc.Options{}, err
+	}
+
+	loader, err := resolveSchemaLoader(options.URIMappings)
+	if err != nil {
+		return schemadoc.Options{}, err
 	}
 
-	if templatePath != "" {
+	renderOptions := schemadoc.Options{
+		Format:             options.Format,
+		ManSection:         options.ManPage.Section,
+		ManDate:            options.ManPage.Date,
+		ManSource:          options.ManPage.Source,
+		Title:              options.Title,
+		SourcePath:         sourcePath,
+		TemplateName:       options.TemplateName,
+		WrapWidth:          options.WrapWidth,
+		NestedDepth:        options.NestedDepth,
+		DiagramDepth:       options.DiagramDepth,
+		HeadingOffset:      options.HeadingOffset,
+		RootDefinition:     options.RootDefinition,
+		PropertyOrder:      schemadoc.PropertyOrder(options.PropertyOrder),
+		ListMarker:         options.ListMarker,
+		ExampleMode:        mode,
+		ExampleFormat:      format,
+		PageName:           options.Pages.PageName,
+		IndexPage:          options.Pages.IndexPage,
+		IncludeDefinitions: options.Filter.Include,
+		ExcludeDefinitions: options.Filter.Exclude,
+		DropOrphans:        options.Filter.DropOrphans,
+		SettingsIndex:      options.SettingsIndex,
+		BaseDir:            sourceBaseDir(sourcePath),
+		Loader:             loader,
+	}
+
+	if templatePath := options.TemplatePath; templatePath != "" {
 		customTemplate, err := os.ReadFile(templatePath)
 		if err != nil {
-			return fmt.Errorf("read template file %q: %w", templatePath, err)
+			return schemadoc.Options{}, fmt.Errorf("read template file %q: %w", templatePath, err)
 		}
 
 		renderOptions.TemplateText = string(customTemplate)
 	}
 
-	rendered, err := schemadoc.Render(schemaBytes, renderOptions)
+	return renderOptions, nil
+}
+
+// warnSchemaDraft writes warning to stderr when schema draft is missing or unsupported.
+func (runner *cliRunner) warnSchemaDraft(schemaBytes []byte) {
+	draftURI := extractSchemaDraftURI(schemaBytes)
+	draft := schemadoc.DetectDraft(draftURI)
+	if strings.TrimSpace(draftURI) == "" {
+		_, _ = fmt.Fprintln(runner.stderr, "warning: schema has no $schema value; draft support is unknown")
+	} else if !draft.Supported {
+		_, _ = fmt.Fprintf(runner.stderr, "warning: unsupported $schema value %q\n", draftURI)
+	}
+}
+
+// writePages renders multi-page markdown and writes index and definition pages into output directory.
+func (runner *cliRunner) writePages(schemaBytes []byte, renderOptions schemadoc.Options, outputDir string) error {
+	pages, err := schemadoc.RenderPages(schemaBytes, renderOptions)
 	if err != nil {
-		return fmt.Errorf("render markdown: %w", err)
+		return fmt.Errorf("render markdown pages: %w", err)
 	}
 
+	if err := os.MkdirAll(outputDir, 0o750); err != nil {
+		return fmt.Errorf("create output directory %q: %w", outputDir, err)
+	}
+
+	for _, page := range pages {
+		pagePath := filepath.Join(outputDir, page.Path)
+		if err := os.WriteFile(pagePath, []byte(page.Content), 0o600); err != nil {
+			return fmt.Errorf("write markdown page %q: %w", pagePath, err)
+		}
+	}
+
+	return nil
+}
+
+// writeOutput writes generated content to stdout when output path is empty, otherwise to file.
+func (runner *cliRunner) writeOutput(content []byte, formatName, outputPath string) error {
 	if strings.TrimSpace(outputPath) == "" {
-		if _, err := io.WriteString(runner.stdout, rendered); err != nil {
-			return fmt.Errorf("write markdown to stdout: %w", err)
+		if _, err := runner.stdout.Write(content); err != nil {
+			return fmt.Errorf("write %s to stdout: %w", formatName, err)
 		}
 
 		return nil
 	}
 
-	if err := os.WriteFile(outputPath, []byte(rendered), 0o600); err != nil {
-		return fmt.Errorf("write markdown file %q: %w", outputPath, err)
+	if err := os.WriteFile(outputPath, content, 0o600); err != nil {
+		return fmt.Errorf("write %s file %q: %w", formatName, outputPath, err)
 	}
 
 	return nil
 }
 
+// runBundle merges external schema references and writes single schema to stdout or file.
+func (runner *cliRunner) runBundle(uriMappings []string, inputPath, outputPath string) error {
+	schemaBytes, sourcePath, err := runner.readSchemaInput(inputPath)
+	if err != nil {
+		return fmt.Errorf("read schema input: %w", err)
+	}
+
+	loader, err := resolveSchemaLoader(uriMappings)
+	if err != nil {
+		return err
+	}
+
+	content, err := schemadoc.Bundle(schemaBytes, schemadoc.Options{
+		SourcePath: sourcePath,
+		BaseDir:    sourceBaseDir(sourcePath),
+		Loader:     loader,
+	})
+	if err != nil {
+		return fmt.Errorf("bundle schema: %w", err)
+	}
+
+	return runner.writeOutput(content, "schema", outputPath)
+}
+
 // runTemplate writes selected built-in template to stdout or file.
 func (runner *cliRunner) runTemplate(templateName, outputPath string) error {
 	tpl, err := schemadoc.BuiltinTemplate(templateName)
@@ -487,6 +1041,18 @@ func (runner *cliRunner) readSchemaInput(path string) ([]byte, string, error) {
 	return data, "(stdin)", nil
 }
 
+// sourceBaseDir returns directory for resolving relative schema references of file input.
+//
+// It returns empty string for stdin and reflected module sources.
+func sourceBaseDir(sourcePath string) string {
+	sourcePath = strings.TrimSpace(sourcePath)
+	if sourcePath == "" || sourcePath == "(stdin)" || strings.HasPrefix(sourcePath, "module:") {
+		return ""
+	}
+
+	return filepath.Dir(sourcePath)
+}
+
 // writeCLIError writes a plain-text CLI error line to the selected stream.
 func writeCLIError(output io.Writer, err error) {
 	if err == nil {
@@ -503,9 +1069,17 @@ func parseCLIArgs(args []string, runner *cliRunner) error {
 	options.ModuleToMarkdown.runner = runner
 	options.ModuleToSchema.runner = runner
 	options.SchemaToMarkdown.runner = runner
+	options.SchemaToHTML.runner = runner
+	options.SchemaToAsciiDoc.runner = runner
+	options.SchemaToRST.runner = runner
+	options.SchemaToMan.runner = runner
+	options.SchemaToView.runner = runner
+	options.SchemaToDOT.runner = runner
 	options.SchemaToJSON.runner = runner
 	options.SchemaToYAML.runner = runner
 	options.Template.runner = runner
+	options.Bundle.runner = runner
+	options.Generate.runner = runner
 
 	parser := flags.NewParser(options, flags.HelpFlag)
 	parser.Name = runner.programName
@@ -523,7 +1097,7 @@ func parseCLIArgs(args []string, runner *cliRunner) error {
 func applyCommandLongDescriptions(parser *flags.Parser, programName string) {
 	descriptions := map[string]string{
 		"template": strings.TrimSpace(fmt.Sprintf(`
-Print built-in markdown template text (`+"`list` or `table`"+`).
+Print built-in template text (`+"`list`, `table`, `html`, `asciidoc`, `rst` or `man`"+`).
 Use it as a starting point for a custom template file.
 
 Examples:
@@ -534,28 +1108,141 @@ Examples:
 Convert JSON Schema to markdown.
 Reads schema from file argument or stdin; writes markdown to file argument or stdout.
 Use --format json|yaml to append example payload code block at the end.
+Use --map-uri to resolve absolute $ref URIs from local directories.
+Use --output-dir to write index page and one linked page per definition.
+Use --inject NAME to replace <!-- schemadoc:begin NAME --> ... <!-- schemadoc:end NAME -->
+block of existing output file; begin marker may set template, title, heading-offset,
+root, property-order, mode and format, for example
+<!-- schemadoc:begin config template=table heading-offset=1 -->.
+Use --check to compare output with existing file and fail with unified diff on drift.
+Use --watch to regenerate output whenever input schema, referenced schema files
+or --template-file change; files are polled, render errors are reported and watching goes on.
 
 Examples:
 > $ %s schema2md schema.json > schema.md
 > $ cat schema.json | %s schema2md -t table > schema.table.md
 > $ %s schema2md --mode required --format yaml schema.json > schema.with-example.md
-`, programName, programName, programName)),
+> $ %s schema2md --map-uri https://example.com/schemas/=./schemas schema.json > schema.md
+> $ %s schema2md --output-dir docs/config --index-page README.md schema.json
+> $ %s schema2md --check schema.json docs/schema.md
+> $ %s schema2md --inject config schema.json README.md
+> $ %s schema2md --watch --template-file config.gotmpl schema.json docs/schema.md
+`, programName, programName, programName, programName, programName, programName, programName, programName)),
+		"schema2html": strings.TrimSpace(fmt.Sprintf(`
+Convert JSON Schema to standalone HTML page with embedded stylesheet.
+Definitions are collapsible and every section has anchor link.
+Reads schema from file argument or stdin; writes HTML to file argument or stdout.
+Use --template-file for custom html/template page.
+
+Examples:
+> $ %s schema2html schema.json > schema.html
+> $ %s schema2html --format yaml schema.json site/config.html
+`, programName, programName)),
+		"schema2adoc": strings.TrimSpace(fmt.Sprintf(`
+Convert JSON Schema to AsciiDoc document for Asciidoctor or Antora sites.
+Markdown in schema descriptions is converted to AsciiDoc syntax.
+Reads schema from file argument or stdin; writes AsciiDoc to file argument or stdout.
+Use --template-file for custom template with AsciiDoc helper functions.
+
+Examples:
+> $ %s schema2adoc schema.json > schema.adoc
+> $ %s schema2adoc --format yaml schema.json docs/modules/ROOT/pages/config.adoc
+`, programName, programName)),
+		"schema2rst": strings.TrimSpace(fmt.Sprintf(`
+Convert JSON Schema to reStructuredText document for Sphinx or docutils.
+Markdown in schema descriptions is converted to reStructuredText syntax.
+Reads schema from file argument or stdin; writes reStructuredText to file argument or stdout.
+Use --template-file for custom template with reStructuredText helper functions.
+
+Examples:
+> $ %s schema2rst schema.json > schema.rst
+> $ %s schema2rst --format yaml schema.json docs/config.rst
+`, programName, programName)),
+		"schema2man": strings.TrimSpace(fmt.Sprintf(`
+Convert JSON Schema to roff man page, section 5 (file formats) by default.
+Title is used as page name, for example --title myapp.conf;
+first sentence of root definition description becomes NAME summary.
+Every property path gets its own entry with type, default and constraints.
+Reads schema from file argument or stdin; writes roff to file argument or stdout.
+
+Examples:
+> $ %s schema2man --title myapp.conf schema.json > myapp.conf.5
+> $ %s schema2man -T myapp.conf --date 2026-01-31 --source "myapp 1.4.0" schema.json man/myapp.conf.5
+`, programName, programName)),
+		"schema2view": strings.TrimSpace(fmt.Sprintf(`
+Export documentation view model of JSON Schema as JSON for external tooling.
+The model holds definitions, property paths, attributes, rules and variants
+exactly as templates see them; every text value has markdown and raw form.
+Reads schema from file argument or stdin; writes JSON to file argument or stdout.
+Use --settings-index to add index of every leaf property path.
+
+Examples:
+> $ %s schema2view schema.json > schema.view.json
+> $ %s schema2view --format yaml schema.json site/data/config.json
+`, programName, programName)),
+		"schema2dot": strings.TrimSpace(fmt.Sprintf(`
+Convert JSON Schema definition reference graph to Graphviz DOT.
+Nodes are definitions reachable from root definition; edges are labeled
+with property paths and edges of reference cycles are dashed.
+Use --diagram-depth to limit reference hops from root definition.
+Reads schema from file argument or stdin; writes DOT to file argument or stdout.
+
+Examples:
+> $ %s schema2dot schema.json | dot -Tsvg > schema.svg
+> $ %s schema2dot --root Server --diagram-depth 2 schema.json schema.dot
+`, programName, programName)),
 		"schema2json": strings.TrimSpace(fmt.Sprintf(`
 Generate example JSON payload from schema.
 Reads schema from file argument or stdin; writes JSON to file argument or stdout.
+Use --inject NAME to replace marker block of existing markdown file with fenced example;
+begin marker may set mode and property-order.
+Use --check to compare output with existing file and fail with unified diff on drift.
 
 Examples:
 > $ %s schema2json schema.json > example.json
 > $ %s schema2json --mode required schema.json example.required.json
-`, programName, programName)),
+> $ %s schema2json --check schema.json example.json
+> $ %s schema2json --inject example schema.json README.md
+`, programName, programName, programName, programName)),
 		"schema2yaml": strings.TrimSpace(fmt.Sprintf(`
 Generate example YAML payload from schema.
 Reads schema from file argument or stdin; writes YAML to file argument or stdout.
+Use --inject NAME to replace marker block of existing markdown file with fenced example;
+begin marker may set mode and property-order.
+Use --check to compare output with existing file and fail with unified diff on drift.
 
 Examples:
 > $ %s schema2yaml schema.json > example.yaml
 > $ %s schema2yaml --mode all schema.json example.all.yaml
+> $ %s schema2yaml --check schema.json example.yaml
+> $ %s schema2yaml --inject example schema.json README.md
+`, programName, programName, programName, programName)),
+		"bundle": strings.TrimSpace(fmt.Sprintf(`
+Merge externally referenced schema files into one schema.
+Relative file references in `+"`$ref`"+` are resolved from the input schema directory,
+referenced definitions are copied into `+"`$defs`"+` and references are rewritten to local pointers.
+
+Examples:
+> $ %s bundle config.schema.json > config.bundle.json
+> $ %s bundle schemas/config.json dist/config.schema.json
 `, programName, programName)),
+		"generate": strings.TrimSpace(fmt.Sprintf(`
+Run generation jobs listed in project file, by default schemadoc.yaml,
+schemadoc.yml or schemadoc.json in current directory.
+Every job reads schema file or reflects Go module type and writes its outputs:
+markdown, html, asciidoc, rst, man, json, yaml, view or dot.
+Project file is validated against published schema before any job runs;
+relative paths are resolved from project file directory.
+Use --job to run selected jobs and --check to verify all outputs are up to date.
+Use --watch to rerun jobs whenever project file, job schemas, referenced schema files
+or template files change; Go sources of module jobs are not watched.
+
+Examples:
+> $ %s generate
+> $ %s generate --job config docs/schemadoc.yaml
+> $ %s generate --check
+> $ %s generate --watch --job config
+`, programName, programName, programName, programName)),
 		"mod2schema": strings.TrimSpace(fmt.Sprintf(`
 Reflect Go type into JSON Schema.
 Use module import path as positional argument.
@@ -570,6 +1257,9 @@ Generate markdown directly from Go type.
 This is `+"`mod2schema` + `schema2md`"+` in one command.
 Use the same module/package/type selection rules as `+"`mod2schema`"+`.
 Use --format json|yaml to append example payload code block at the end.
+Use --output-dir to write index page and one linked page per definition.
+Use --inject NAME to replace marker block of existing output file, as in `+"`schema2md`"+`.
+Use --check to compare output with existing file and fail with unified diff on drift.
 
 Examples:
 > $ %s mod2md --module-root . --type Config github.com/acme/project > model.md
@@ -632,11 +1322,36 @@ func resolveMarkdownExampleOptions(modeRaw, formatRaw string) (schemadoc.Example
 	return mode, format, nil
 }
 
-// extractSchemaDraftURI returns raw $schema value from schema document.
+// resolveSchemaLoader builds offline URI loader from PREFIX=DIR mapping flag values.
+//
+// It returns nil loader when no mappings are configured.
+func resolveSchemaLoader(mappings []string) (schemadoc.Loader, error) {
+	if len(mappings) == 0 {
+		return nil, nil
+	}
+
+	loader := schemadoc.NewMapLoader()
+	for _, mapping := range mappings {
+		prefix, dir, ok := strings.Cut(mapping, "=")
+		prefix = strings.TrimSpace(prefix)
+		dir = strings.TrimSpace(dir)
+		if !ok || prefix == "" || dir == "" {
+			return nil, fmt.Errorf("invalid uri mapping %q; expected PREFIX=DIR", mapping)
+		}
+
+		loader.MapDir(prefix, dir)
+	}
+
+	return loader, nil
+}
+
+// extractSchemaDraftURI returns raw $schema value from JSON or YAML schema document.
 func extractSchemaDraftURI(schemaBytes []byte) string {
 	var root map[string]any
 	if err := json.Unmarshal(schemaBytes, &root); err != nil {
-		return ""
+		if err := yaml.Unmarshal(schemaBytes, &root); err != nil {
+			return ""
+		}
 	}
 
 	value, ok := root["$schema"].(string)
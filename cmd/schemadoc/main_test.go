@@ -6,10 +6,13 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 const testModulePath = "github.com/woozymasta/schemadoc"
@@ -156,6 +159,71 @@ func TestRunSchemaToMarkdownWrapWidth(t *testing.T) {
 	}
 }
 
+func TestRunSchemaToMarkdownNestedDepth(t *testing.T) {
+	t.Parallel()
+
+	schema := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "server": {
+      "type": "object",
+      "properties": { "host": { "type": "string" } }
+    }
+  }
+}`
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := runWithIO([]string{"schema2md"}, strings.NewReader(schema), &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	assertContains(t, stdout.String(), "### Root.server.host")
+
+	stdout.Reset()
+	code = runWithIO([]string{"schema2md", "--nested-depth", "-1"}, strings.NewReader(schema), &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	assertNotContains(t, stdout.String(), "### Root.server.host")
+}
+
+func TestRunSchemaToMarkdownRootDefinition(t *testing.T) {
+	t.Parallel()
+
+	schema := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$defs": {
+    "Config": { "type": "object" },
+    "Server": {
+      "type": "object",
+      "properties": { "host": { "type": "string" } }
+    }
+  }
+}`
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := runWithIO([]string{"schema2md", "--root", "Server"}, strings.NewReader(schema), &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	if strings.Index(stdout.String(), "## Server") > strings.Index(stdout.String(), "## Config") {
+		t.Fatalf("root definition is not rendered first: %s", stdout.String())
+	}
+
+	code = runWithIO([]string{"schema2md", "--root", "Missing"}, strings.NewReader(schema), &stdout, &stderr)
+	if code == 0 {
+		t.Fatal("expected non-zero exit code for unknown root definition")
+	}
+
+	assertContains(t, stderr.String(), "unknown root definition")
+}
+
 func TestRunSchemaToMarkdownListMarker(t *testing.T) {
 	t.Parallel()
 
@@ -171,173 +239,915 @@ func TestRunSchemaToMarkdownListMarker(t *testing.T) {
   }
 }`)
 
-	var stdout bytes.Buffer
-	var stderr bytes.Buffer
-	code := runWithIO([]string{"schema2md", "--list-marker", "*"}, stdin, &stdout, &stderr)
-	if code != 0 {
-		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := runWithIO([]string{"schema2md", "--list-marker", "*"}, stdin, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	if !strings.Contains(stdout.String(), "* list") {
+		t.Fatalf("expected normalized list marker in output: %s", stdout.String())
+	}
+
+	if strings.Contains(stdout.String(), "\n - list") {
+		t.Fatalf("expected list indent normalization, got: %s", stdout.String())
+	}
+}
+
+func TestRunSchemaToMarkdownListMarkerAffectsTemplateLists(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeSchemaFixture(t, "https://json-schema.org/draft/2020-12/schema")
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := run([]string{"schema2md", "--list-marker", "-", schemaPath}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	if !strings.Contains(stdout.String(), "- Schema ID:") {
+		t.Fatalf("expected template metadata list marker '-', got: %s", stdout.String())
+	}
+
+	if strings.Contains(stdout.String(), "* Schema ID:") {
+		t.Fatalf("expected template metadata marker to be '-', got: %s", stdout.String())
+	}
+}
+
+func TestRunTemplateStdout(t *testing.T) {
+	t.Parallel()
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := run([]string{"template"}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	if !strings.Contains(stdout.String(), "# {{ .Title }}") {
+		t.Fatalf("expected template body, got: %s", stdout.String())
+	}
+}
+
+func TestRunTemplateToOutputFile(t *testing.T) {
+	t.Parallel()
+
+	outPath := filepath.Join(t.TempDir(), "table.gotmpl")
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := run([]string{"template", "--template", "table", outPath}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	content, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read exported template: %v", err)
+	}
+
+	if !strings.Contains(string(content), "| Attribute | Value |") {
+		t.Fatalf("expected table template, got: %s", string(content))
+	}
+}
+
+func TestRunSchemaToHTMLWritesPageToOutputFile(t *testing.T) {
+	t.Parallel()
+
+	schema := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "description": "Settings <b>page</b>.",
+  "properties": { "host": { "type": "string" } }
+}`
+
+	dir := t.TempDir()
+	inputPath := filepath.Join(dir, "schema.json")
+	if err := os.WriteFile(inputPath, []byte(schema), 0o600); err != nil {
+		t.Fatalf("write schema: %v", err)
+	}
+
+	outPath := filepath.Join(dir, "schema.html")
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := run([]string{"schema2html", "--title", "Settings", inputPath, outPath}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	content, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read html output: %v", err)
+	}
+
+	assertContains(t, string(content), "<title>Settings</title>")
+	assertContains(t, string(content), `<details class="definition" id="root" open>`)
+	assertContains(t, string(content), "<p>Settings &lt;b&gt;page&lt;/b&gt;.</p>")
+	assertContains(t, string(content), `<section class="property" id="roothost">`)
+}
+
+func TestRunSchemaToTextFormatsWriteStdout(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeSchemaExampleFixture(t)
+	tests := []struct {
+		command string
+		want    string
+	}{
+		{command: "schema2adoc", want: "= Settings\n"},
+		{command: "schema2rst", want: "========\nSettings\n========\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.command, func(t *testing.T) {
+			t.Parallel()
+
+			var stdout bytes.Buffer
+			var stderr bytes.Buffer
+			code := run([]string{test.command, "--title", "Settings", schemaPath}, &stdout, &stderr)
+			if code != 0 {
+				t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+			}
+
+			assertContains(t, stdout.String(), test.want)
+		})
+	}
+}
+
+func TestRunSchemaToManWritesHeaderFlags(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeSchemaExampleFixture(t)
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := run([]string{"schema2man", "-T", "demo.conf", "-s", "5", "--date", "2026-01-31", "--source", "demo 1.0", schemaPath}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	assertContains(t, stdout.String(), `.TH "DEMO.CONF" "5" "2026\-01\-31" "demo 1.0" "File Formats Manual"`)
+	assertContains(t, stdout.String(), ".SH OPTIONS\n")
+}
+
+func TestRunSchemaToMarkdownWritesOutputDir(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeSchemaExampleFixture(t)
+	outputDir := filepath.Join(t.TempDir(), "docs")
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := run([]string{"schema2md", "--output-dir", outputDir, "--index-page", "README.md", schemaPath}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	if stdout.Len() != 0 {
+		t.Fatalf("expected empty stdout, got: %s", stdout.String())
+	}
+
+	index, err := os.ReadFile(filepath.Join(outputDir, "README.md"))
+	if err != nil {
+		t.Fatalf("read index page: %v", err)
+	}
+
+	assertContains(t, string(index), "## Contents")
+
+	entries, err := os.ReadDir(outputDir)
+	if err != nil {
+		t.Fatalf("read output dir: %v", err)
+	}
+
+	if len(entries) < 2 {
+		t.Fatalf("expected index and definition pages, got %d files", len(entries))
+	}
+
+	code = run([]string{"schema2md", "--output-dir", outputDir, schemaPath, filepath.Join(outputDir, "out.md")}, &stdout, &stderr)
+	if code != 1 {
+		t.Fatalf("expected exit code 1 for output file with --output-dir, got %d", code)
+	}
+}
+
+func TestRunSchemaToDOTWritesGraph(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeSchemaExampleFixture(t)
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := run([]string{"schema2dot", "-T", "demo", "--diagram-depth", "1", schemaPath}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	assertContains(t, stdout.String(), "digraph schema {\n  label=\"demo\";\n")
+	assertContains(t, stdout.String(), "  \"Config\" [style=bold];\n")
+}
+
+func TestRunSchemaToMarkdownFiltersDefinitions(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := filepath.Join(t.TempDir(), "schema.json")
+	body := `{
+  "$ref": "#/$defs/Config",
+  "$defs": {
+    "Config": {"type": "object", "properties": {"server": {"$ref": "#/$defs/Server"}}},
+    "Server": {"type": "object", "properties": {"port": {"type": "integer"}}},
+    "internalState": {"type": "object"}
+  }
+}`
+	if err := os.WriteFile(schemaPath, []byte(body), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := run([]string{"schema2md", "--exclude", "Serv*", "--drop-orphans", schemaPath}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	assertContains(t, stdout.String(), "## Config")
+	assertContains(t, stdout.String(), "Reference: `#/$defs/Server`")
+	assertNotContains(t, stdout.String(), "## Server")
+	assertNotContains(t, stdout.String(), "## internalState")
+}
+
+func TestRunSchemaToMarkdownWritesSettingsIndex(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeSchemaExampleFixture(t)
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := run([]string{"schema2md", "-t", "table", "--settings-index", schemaPath}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	assertContains(t, stdout.String(), "## All settings")
+	assertContains(t, stdout.String(), "| [`settings.enabled`](#configsettingsenabled) | `boolean` | yes | `true` | Enables processing pipeline. |")
+}
+
+func TestRunSchemaToMarkdownCheckReportsDrift(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeSchemaExampleFixture(t)
+	outputPath := filepath.Join(t.TempDir(), "schema.md")
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if code := run([]string{"schema2md", schemaPath, outputPath}, &stdout, &stderr); code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	if code := run([]string{"schema2md", "--check", schemaPath, outputPath}, &stdout, &stderr); code != 0 {
+		t.Fatalf("check exit code = %d, stdout: %s, stderr: %s", code, stdout.String(), stderr.String())
+	}
+
+	if stdout.Len() != 0 {
+		t.Fatalf("check stdout = %q, want empty", stdout.String())
+	}
+
+	if code := run([]string{"schema2md", "-T", "renamed", "--check", schemaPath, outputPath}, &stdout, &stderr); code != 1 {
+		t.Fatalf("check exit code = %d, want 1", code)
+	}
+
+	assertContains(t, stdout.String(), "--- "+outputPath+"\n+++ "+outputPath+" (generated)\n@@ -1,4 +1,4 @@\n-# schema reference\n+# renamed\n")
+	assertContains(t, stderr.String(), "is out of date")
+
+	written, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+
+	assertContains(t, string(written), "# schema reference")
+}
+
+func TestRunSchemaToExampleCheckReportsMissingFile(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeSchemaExampleFixture(t)
+	outputPath := filepath.Join(t.TempDir(), "example.yaml")
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if code := run([]string{"schema2yaml", "--check", schemaPath, outputPath}, &stdout, &stderr); code != 1 {
+		t.Fatalf("check exit code = %d, want 1", code)
+	}
+
+	assertContains(t, stdout.String(), "@@ -0,0 +1,")
+	assertContains(t, stdout.String(), "+name: demo\n")
+
+	if _, err := os.Stat(outputPath); !os.IsNotExist(err) {
+		t.Fatalf("check created output file, stat error: %v", err)
+	}
+
+	stderr.Reset()
+	if code := run([]string{"schema2json", "--check", schemaPath}, &stdout, &stderr); code != 1 {
+		t.Fatalf("check without output exit code = %d, want 1", code)
+	}
+
+	assertContains(t, stderr.String(), "--check requires output file")
+}
+
+func TestRunSchemaToMarkdownCheckComparesPages(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeSchemaExampleFixture(t)
+	outputDir := t.TempDir()
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if code := run([]string{"schema2md", "-D", outputDir, schemaPath}, &stdout, &stderr); code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	if code := run([]string{"schema2md", "-D", outputDir, "--check", schemaPath}, &stdout, &stderr); code != 0 {
+		t.Fatalf("check exit code = %d, stdout: %s, stderr: %s", code, stdout.String(), stderr.String())
+	}
+
+	if err := os.WriteFile(filepath.Join(outputDir, "config.md"), []byte("stale\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if code := run([]string{"schema2md", "-D", outputDir, "--check", schemaPath}, &stdout, &stderr); code != 1 {
+		t.Fatalf("check exit code = %d, want 1", code)
+	}
+
+	assertContains(t, stdout.String(), "-stale\n")
+	assertContains(t, stderr.String(), "1 of 2 markdown pages")
+}
+
+func TestRunSchemaToMarkdownInjectsMarkerBlocks(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeSchemaExampleFixture(t)
+	readmePath := filepath.Join(t.TempDir(), "README.md")
+	readme := "# Demo\n\nIntro.\n\n" +
+		"<!-- schemadoc:begin config template=table heading-offset=1 title=\"Config Reference\" -->\n" +
+		"<!-- schemadoc:end config -->\n\n" +
+		"## Example\n\n<!-- schemadoc:begin example mode=required -->\n<!-- schemadoc:end example -->\n"
+	if err := os.WriteFile(readmePath, []byte(readme), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if code := run([]string{"schema2md", "--inject", "config", schemaPath, readmePath}, &stdout, &stderr); code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	if code := run([]string{"schema2yaml", "--inject", "example", schemaPath, readmePath}, &stdout, &stderr); code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	written, err := os.ReadFile(readmePath)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+
+	assertContains(t, string(written), "# Demo\n\nIntro.\n\n<!-- schemadoc:begin config")
+	assertContains(t, string(written), "-->\n\n## Config Reference\n")
+	assertContains(t, string(written), "### Config\n\n| Attribute | Value |\n")
+	assertContains(t, string(written), "<!-- schemadoc:begin example mode=required -->\n\n```yaml\n")
+	assertNotContains(t, string(written), "note: ")
+	assertContains(t, string(written), "```\n\n<!-- schemadoc:end example -->\n")
+
+	if code := run([]string{"schema2md", "--inject", "config", "--check", schemaPath, readmePath}, &stdout, &stderr); code != 0 {
+		t.Fatalf("check exit code = %d, stdout: %s, stderr: %s", code, stdout.String(), stderr.String())
+	}
+
+	if code := run([]string{"schema2md", "--inject", "missing", schemaPath, readmePath}, &stdout, &stderr); code != 1 {
+		t.Fatalf("missing block exit code = %d, want 1", code)
+	}
+
+	assertContains(t, stderr.String(), `injection block not found "missing"`)
+}
+
+func TestRunSchemaToMarkdownInjectRejectsUnknownMarkerOption(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeSchemaExampleFixture(t)
+	readmePath := filepath.Join(t.TempDir(), "README.md")
+	readme := "<!-- schemadoc:begin config wrap=40 -->\n<!-- schemadoc:end config -->\n"
+	if err := os.WriteFile(readmePath, []byte(readme), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if code := run([]string{"schema2md", "--inject", "config", schemaPath, readmePath}, &stdout, &stderr); code != 1 {
+		t.Fatalf("run exit code = %d, want 1", code)
+	}
+
+	assertContains(t, stderr.String(), `unsupported marker option "wrap" in block "config"`)
+
+	if code := run([]string{"schema2md", "--inject", "config", schemaPath}, &stdout, &stderr); code != 1 {
+		t.Fatalf("run without output exit code = %d, want 1", code)
+	}
+
+	assertContains(t, stderr.String(), "--inject requires output file")
+}
+
+func TestRunSchemaToViewWritesJSON(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeSchemaExampleFixture(t)
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := run([]string{"schema2view", "-T", "demo", "-F", "yaml", schemaPath}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	assertContains(t, stdout.String(), `"title": "demo"`)
+	assertContains(t, stdout.String(), `"format": "yaml"`)
+	assertContains(t, stdout.String(), `"version": 1`)
+}
+
+func TestRunTemplateExportsHTML(t *testing.T) {
+	t.Parallel()
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := run([]string{"template", "-t", "html"}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	assertContains(t, stdout.String(), "<!DOCTYPE html>")
+	assertContains(t, stdout.String(), "{{ stylesheet }}")
+}
+
+func TestRunSchemaToJSONWritesDefaultAllToStdout(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeSchemaExampleFixture(t)
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := run([]string{"schema2json", schemaPath}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	if stdout.Len() == 0 {
+		t.Fatal("stdout is empty")
+	}
+
+	assertContains(t, stdout.String(), `"mode": "safe"`)
+	assertContains(t, stdout.String(), `"note": "<string>"`)
+}
+
+func TestRunSchemaToJSONWritesRequiredToOutputFile(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeSchemaExampleFixture(t)
+	outputPath := filepath.Join(t.TempDir(), "config.required.json")
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := run([]string{"schema2json", "--mode", "required", schemaPath, outputPath}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	if stdout.Len() != 0 {
+		t.Fatalf("stdout should be empty when output path is provided, got: %s", stdout.String())
+	}
+
+	requiredJSON, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("read required json example: %v", err)
+	}
+
+	assertContains(t, string(requiredJSON), `"name": "demo"`)
+	assertContains(t, string(requiredJSON), `"settings": {`)
+	assertNotContains(t, string(requiredJSON), `"mode": "safe"`)
+	assertNotContains(t, string(requiredJSON), `"note": "<string>"`)
+}
+
+func TestRunSchemaToJSONPropertyOrder(t *testing.T) {
+	t.Parallel()
+
+	schema := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "zone": { "type": "string" },
+    "address": { "type": "string" }
+  }
+}`
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := runWithIO([]string{"schema2json"}, strings.NewReader(schema), &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	if strings.Index(stdout.String(), `"zone"`) > strings.Index(stdout.String(), `"address"`) {
+		t.Fatalf("source order is not preserved: %s", stdout.String())
+	}
+
+	stdout.Reset()
+	code = runWithIO([]string{"schema2json", "--property-order", "alphabetical"}, strings.NewReader(schema), &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	if strings.Index(stdout.String(), `"address"`) > strings.Index(stdout.String(), `"zone"`) {
+		t.Fatalf("alphabetical order is not applied: %s", stdout.String())
+	}
+}
+
+func TestRunSchemaToYAMLIncludesSchemaComments(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeSchemaExampleFixture(t)
+	outputPath := filepath.Join(t.TempDir(), "config.required.yaml")
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := run([]string{"schema2yaml", "--mode", "required", schemaPath, outputPath}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	if stdout.Len() != 0 {
+		t.Fatalf("stdout should be empty when output path is provided, got: %s", stdout.String())
+	}
+
+	requiredYAML, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("read required yaml example: %v", err)
+	}
+
+	assertContains(t, string(requiredYAML), "# Service Name")
+	assertContains(t, string(requiredYAML), "# Human-readable service name.")
+	assertContains(t, string(requiredYAML), "name: demo")
+	assertContains(t, string(requiredYAML), "# Enabled")
+	assertContains(t, string(requiredYAML), "# Enables processing pipeline.")
+	assertContains(t, string(requiredYAML), "enabled: true")
+}
+
+func TestRunSchemaToMarkdownEmbedsExampleWithModeAndFormat(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeSchemaExampleFixture(t)
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := run([]string{"schema2md", "--mode", "required", "--format", "yaml", schemaPath}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	rendered := stdout.String()
+	assertContains(t, rendered, "## Example yaml document")
+	assertContains(t, rendered, "```yaml")
+	assertContains(t, rendered, "name: demo")
+	assertContains(t, rendered, "enabled: true")
+	assertNotContains(t, rendered, "mode: safe")
+}
+
+func TestRunGenerateRunsProjectJobs(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeSchemaExampleFixture(t)
+	dir := filepath.Dir(schemaPath)
+	readme := "# Demo\n\n<!-- schemadoc:begin example -->\n<!-- schemadoc:end example -->\n"
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte(readme), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	project := `jobs:
+  - name: config
+    schema: schema.example.json
+    options:
+      title: Config Reference
+      template_name: table
+    outputs:
+      - {path: docs/config.md, format: markdown}
+      - {path: docs/config.html, format: html}
+      - {path: docs/pages, format: markdown, pages: true}
+      - {path: README.md, format: yaml, inject: example}
+`
+	projectPath := filepath.Join(dir, "schemadoc.yaml")
+	if err := os.WriteFile(projectPath, []byte(project), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if code := run([]string{"generate", projectPath}, &stdout, &stderr); code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	markdown, err := os.ReadFile(filepath.Join(dir, "docs", "config.md"))
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+
+	assertContains(t, string(markdown), "# Config Reference\n")
+	assertContains(t, string(markdown), "| Attribute | Value |")
+
+	html, err := os.ReadFile(filepath.Join(dir, "docs", "config.html"))
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+
+	assertContains(t, string(html), "<!DOCTYPE html>")
+
+	if _, err := os.Stat(filepath.Join(dir, "docs", "pages", "index.md")); err != nil {
+		t.Fatalf("index page was not written: %v", err)
+	}
+
+	written, err := os.ReadFile(filepath.Join(dir, "README.md"))
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
 	}
 
-	if !strings.Contains(stdout.String(), "* list") {
-		t.Fatalf("expected normalized list marker in output: %s", stdout.String())
+	assertContains(t, string(written), "<!-- schemadoc:begin example -->\n\n```yaml\n")
+
+	if code := run([]string{"generate", "--check", projectPath}, &stdout, &stderr); code != 0 {
+		t.Fatalf("check exit code = %d, stdout: %s, stderr: %s", code, stdout.String(), stderr.String())
 	}
 
-	if strings.Contains(stdout.String(), "\n - list") {
-		t.Fatalf("expected list indent normalization, got: %s", stdout.String())
+	if err := os.WriteFile(filepath.Join(dir, "docs", "config.md"), []byte("stale\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if code := run([]string{"generate", "--check", "--job", "config", projectPath}, &stdout, &stderr); code != 1 {
+		t.Fatalf("check exit code = %d, want 1", code)
+	}
+
+	assertContains(t, stdout.String(), "-stale\n")
+	assertContains(t, stderr.String(), "1 of 4 project outputs are out of date")
+
+	if code := run([]string{"generate", "--job", "missing", projectPath}, &stdout, &stderr); code != 1 {
+		t.Fatalf("unknown job exit code = %d, want 1", code)
 	}
+
+	assertContains(t, stderr.String(), `unknown project job "missing"`)
 }
 
-func TestRunSchemaToMarkdownListMarkerAffectsTemplateLists(t *testing.T) {
+func TestRunGenerateAppliesTemplateFileToMarkdownOnly(t *testing.T) {
 	t.Parallel()
 
-	schemaPath := writeSchemaFixture(t, "https://json-schema.org/draft/2020-12/schema")
+	schemaPath := writeSchemaExampleFixture(t)
+	dir := filepath.Dir(schemaPath)
+	if err := os.WriteFile(filepath.Join(dir, "doc.gotmpl"), []byte("custom {{ .Title }}\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	project := `jobs:
+  - schema: schema.example.json
+    template_file: doc.gotmpl
+    outputs:
+      - {path: config.md, format: markdown}
+      - {path: config.table.md, format: markdown, template: table}
+      - {path: config.html, format: html}
+`
+	projectPath := filepath.Join(dir, "schemadoc.yaml")
+	if err := os.WriteFile(projectPath, []byte(project), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
-	code := run([]string{"schema2md", "--list-marker", "-", schemaPath}, &stdout, &stderr)
-	if code != 0 {
+	if code := run([]string{"generate", projectPath}, &stdout, &stderr); code != 0 {
 		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
 	}
 
-	if !strings.Contains(stdout.String(), "- Schema ID:") {
-		t.Fatalf("expected template metadata list marker '-', got: %s", stdout.String())
+	markdown, err := os.ReadFile(filepath.Join(dir, "config.md"))
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
 	}
 
-	if strings.Contains(stdout.String(), "* Schema ID:") {
-		t.Fatalf("expected template metadata marker to be '-', got: %s", stdout.String())
+	if string(markdown) != "custom schema reference\n" {
+		t.Fatalf("markdown output does not use template file:\n%s", markdown)
+	}
+
+	table, err := os.ReadFile(filepath.Join(dir, "config.table.md"))
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+
+	assertContains(t, string(table), "| Attribute | Value |")
+
+	html, err := os.ReadFile(filepath.Join(dir, "config.html"))
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
 	}
+
+	assertContains(t, string(html), "<!DOCTYPE html>")
+	assertNotContains(t, string(html), "custom schema reference")
 }
 
-func TestRunTemplateStdout(t *testing.T) {
+func TestRunGenerateValidatesProjectFile(t *testing.T) {
 	t.Parallel()
 
+	projectPath := filepath.Join(t.TempDir(), "schemadoc.json")
+	project := `{"jobs": [{"schema": "schema.json", "outputs": [{"path": "out.md", "format": "md"}]}]}`
+	if err := os.WriteFile(projectPath, []byte(project), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
-	code := run([]string{"template"}, &stdout, &stderr)
-	if code != 0 {
-		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	if code := run([]string{"generate", projectPath}, &stdout, &stderr); code != 1 {
+		t.Fatalf("run exit code = %d, want 1", code)
 	}
 
-	if !strings.Contains(stdout.String(), "# {{ .Title }}") {
-		t.Fatalf("expected template body, got: %s", stdout.String())
-	}
+	assertContains(t, stderr.String(), "invalid project file")
+	assertContains(t, stderr.String(), `/jobs/0/outputs/0/format: value "md" is not one of`)
 }
 
-func TestRunTemplateToOutputFile(t *testing.T) {
+func TestRunSchemaToMarkdownWatchRegeneratesOnTemplateChange(t *testing.T) {
 	t.Parallel()
 
-	outPath := filepath.Join(t.TempDir(), "table.gotmpl")
-	var stdout bytes.Buffer
-	var stderr bytes.Buffer
-	code := run([]string{"template", "--template", "table", outPath}, &stdout, &stderr)
-	if code != 0 {
-		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
-	}
+	schemaPath := writeSchemaExampleFixture(t)
+	dir := filepath.Dir(schemaPath)
+	templatePath := filepath.Join(dir, "doc.gotmpl")
+	outputPath := filepath.Join(dir, "doc.md")
+	writeWatchedFile(t, templatePath, "first {{ .Title }}\n")
 
-	content, err := os.ReadFile(outPath)
-	if err != nil {
-		t.Fatalf("read exported template: %v", err)
-	}
+	stderr, stop := startWatchRunner(t, "schema2md", "--watch", "--watch-interval", "5ms", "--watch-debounce", "10ms",
+		"--template-file", templatePath, schemaPath, outputPath)
 
-	if !strings.Contains(string(content), "| Attribute | Value |") {
-		t.Fatalf("expected table template, got: %s", string(content))
+	waitForWatch(t, func() bool { return readWatchedFile(outputPath) == "first schema reference\n" })
+
+	writeWatchedFile(t, templatePath, "{{ .Broken")
+	waitForWatch(t, func() bool { return strings.Contains(stderr.String(), "error: render markdown") })
+
+	writeWatchedFile(t, templatePath, "second {{ .Title }}\n")
+	waitForWatch(t, func() bool { return readWatchedFile(outputPath) == "second schema reference\n" })
+
+	if code := stop(); code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
 	}
+
+	assertContains(t, stderr.String(), "changed: "+templatePath)
+	assertContains(t, stderr.String(), "regenerated")
 }
 
-func TestRunSchemaToJSONWritesDefaultAllToStdout(t *testing.T) {
+func TestRunGenerateWatchRegeneratesOnReferenceChange(t *testing.T) {
 	t.Parallel()
 
-	schemaPath := writeSchemaExampleFixture(t)
+	schemaPath := writeMultiFileSchemaFixture(t)
+	dir := filepath.Dir(schemaPath)
+	projectPath := filepath.Join(dir, "schemadoc.yaml")
+	outputPath := filepath.Join(dir, "docs", "config.md")
+	writeWatchedFile(t, projectPath, "jobs:\n  - {schema: schema.json, outputs: [{path: docs/config.md, format: markdown}]}\n")
 
-	var stdout bytes.Buffer
-	var stderr bytes.Buffer
-	code := run([]string{"schema2json", schemaPath}, &stdout, &stderr)
-	if code != 0 {
+	stderr, stop := startWatchRunner(t, "generate", "--watch", "--watch-interval", "5ms", "--watch-debounce", "10ms", projectPath)
+
+	waitForWatch(t, func() bool { return strings.Contains(readWatchedFile(outputPath), "cert_file") })
+
+	tlsPath := filepath.Join(dir, "common", "tls.json")
+	writeWatchedFile(t, tlsPath, `{"$defs": {"TLS": {"type": "object", "properties": {"key_file": {"type": "string"}}}}}`)
+	waitForWatch(t, func() bool { return strings.Contains(readWatchedFile(outputPath), "key_file") })
+
+	if code := stop(); code != 0 {
 		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
 	}
 
-	if stdout.Len() == 0 {
-		t.Fatal("stdout is empty")
+	assertContains(t, stderr.String(), "changed: "+tlsPath)
+}
+
+func TestRunGenerateWatchPicksUpFixedBrokenReference(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	schemaPath := filepath.Join(dir, "schema.json")
+	projectPath := filepath.Join(dir, "schemadoc.yaml")
+	outputPath := filepath.Join(dir, "docs", "config.md")
+	writeWatchedFile(t, schemaPath, `{"type": "object", "properties": {"name": {"type": "string"}}}`)
+	writeWatchedFile(t, projectPath, "jobs:\n  - {schema: schema.json, outputs: [{path: docs/config.md, format: markdown}]}\n")
+
+	stderr, stop := startWatchRunner(t, "generate", "--watch", "--watch-interval", "5ms", "--watch-debounce", "10ms", projectPath)
+
+	waitForWatch(t, func() bool { return strings.Contains(readWatchedFile(outputPath), "name") })
+
+	writeWatchedFile(t, schemaPath, `{"type": "object", "properties": {"name": {"type": "string"}, "tls": {"$ref": "tls.json"}}}`)
+	waitForWatch(t, func() bool { return strings.Contains(stderr.String(), "error: ") })
+
+	tlsPath := filepath.Join(dir, "tls.json")
+	writeWatchedFile(t, tlsPath, `{"type": "object", "properties": {"cert_file": {"type": "string"}}}`)
+	waitForWatch(t, func() bool { return strings.Contains(readWatchedFile(outputPath), "cert_file") })
+
+	if code := stop(); code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
 	}
 
-	assertContains(t, stdout.String(), `"mode": "safe"`)
-	assertContains(t, stdout.String(), `"note": "<string>"`)
+	assertContains(t, stderr.String(), "changed: "+tlsPath)
 }
 
-func TestRunSchemaToJSONWritesRequiredToOutputFile(t *testing.T) {
+func TestRunWatchRejectsStdinAndCheck(t *testing.T) {
 	t.Parallel()
 
 	schemaPath := writeSchemaExampleFixture(t)
-	outputPath := filepath.Join(t.TempDir(), "config.required.json")
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{args: []string{"schema2md", "--watch"}, want: "--watch requires input schema file"},
+		{args: []string{"schema2md", "--watch", "--check", schemaPath, "out.md"}, want: "--watch and --check are mutually exclusive"},
+		{args: []string{"generate", "--watch", "--check"}, want: "--watch and --check are mutually exclusive"},
+		{args: []string{"schema2md", "--watch", "--watch-interval", "0s", schemaPath}, want: "--watch-interval must be positive"},
+	}
+
+	for _, test := range tests {
+		var stdout bytes.Buffer
+		var stderr bytes.Buffer
+		if code := run(test.args, &stdout, &stderr); code != 1 {
+			t.Fatalf("%q: run exit code = %d, stderr: %s", test.args, code, stderr.String())
+		}
+
+		assertContains(t, stderr.String(), test.want)
+	}
+}
+
+func TestRunBundleMergesExternalReferences(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := writeMultiFileSchemaFixture(t)
+	outputPath := filepath.Join(t.TempDir(), "bundle.json")
 
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
-	code := run([]string{"schema2json", "--mode", "required", schemaPath, outputPath}, &stdout, &stderr)
+	code := run([]string{"bundle", schemaPath, outputPath}, &stdout, &stderr)
 	if code != 0 {
 		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
 	}
 
-	if stdout.Len() != 0 {
-		t.Fatalf("stdout should be empty when output path is provided, got: %s", stdout.String())
-	}
-
-	requiredJSON, err := os.ReadFile(outputPath)
+	bundled, err := os.ReadFile(outputPath)
 	if err != nil {
-		t.Fatalf("read required json example: %v", err)
+		t.Fatalf("read bundled schema: %v", err)
 	}
 
-	assertContains(t, string(requiredJSON), `"name": "demo"`)
-	assertContains(t, string(requiredJSON), `"settings": {`)
-	assertNotContains(t, string(requiredJSON), `"mode": "safe"`)
-	assertNotContains(t, string(requiredJSON), `"note": "<string>"`)
+	assertContains(t, string(bundled), `"$ref": "#/$defs/TLS"`)
+	assertContains(t, string(bundled), `"cert_file": {`)
+	assertNotContains(t, string(bundled), "common/tls.json")
 }
 
-func TestRunSchemaToYAMLIncludesSchemaComments(t *testing.T) {
+func TestRunSchemaToMarkdownResolvesExternalReferences(t *testing.T) {
 	t.Parallel()
 
-	schemaPath := writeSchemaExampleFixture(t)
-	outputPath := filepath.Join(t.TempDir(), "config.required.yaml")
-
+	schemaPath := writeMultiFileSchemaFixture(t)
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
-	code := run([]string{"schema2yaml", "--mode", "required", schemaPath, outputPath}, &stdout, &stderr)
+	code := run([]string{"schema2md", schemaPath}, &stdout, &stderr)
 	if code != 0 {
 		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
 	}
 
-	if stdout.Len() != 0 {
-		t.Fatalf("stdout should be empty when output path is provided, got: %s", stdout.String())
+	assertContains(t, stdout.String(), "## TLS")
+	assertContains(t, stdout.String(), "Path: `tls.cert_file`")
+}
+
+func TestRunSchemaToJSONResolvesMappedURIReferences(t *testing.T) {
+	t.Parallel()
+
+	schemasDir := t.TempDir()
+	baseBody := `{"$defs": {"Base": {"type": "object", "properties": {"owner": {"type": "string", "default": "platform"}}}}}`
+	if err := os.WriteFile(filepath.Join(schemasDir, "base.json"), []byte(baseBody), 0o600); err != nil {
+		t.Fatalf("write base schema: %v", err)
 	}
 
-	requiredYAML, err := os.ReadFile(outputPath)
-	if err != nil {
-		t.Fatalf("read required yaml example: %v", err)
+	stdin := strings.NewReader(`{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "base": { "$ref": "https://example.com/schemas/base.json#/$defs/Base" }
+  }
+}`)
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := runWithIO([]string{"schema2json", "--map-uri", "https://example.com/schemas/=" + schemasDir}, stdin, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
 	}
 
-	assertContains(t, string(requiredYAML), "# Service Name")
-	assertContains(t, string(requiredYAML), "# Human-readable service name.")
-	assertContains(t, string(requiredYAML), "name: demo")
-	assertContains(t, string(requiredYAML), "# Enabled")
-	assertContains(t, string(requiredYAML), "# Enables processing pipeline.")
-	assertContains(t, string(requiredYAML), "enabled: true")
+	assertContains(t, stdout.String(), `"owner": "platform"`)
 }
 
-func TestRunSchemaToMarkdownEmbedsExampleWithModeAndFormat(t *testing.T) {
+func TestRunReturnsErrorForInvalidURIMapping(t *testing.T) {
 	t.Parallel()
 
-	schemaPath := writeSchemaExampleFixture(t)
+	schemaPath := writeSchemaFixture(t, "https://json-schema.org/draft/2020-12/schema")
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
-	code := run([]string{"schema2md", "--mode", "required", "--format", "yaml", schemaPath}, &stdout, &stderr)
-	if code != 0 {
-		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	code := run([]string{"schema2md", "--map-uri", "https://example.com/", schemaPath}, &stdout, &stderr)
+	if code != 1 {
+		t.Fatalf("expected exit code 1, got %d, stderr: %s", code, stderr.String())
 	}
 
-	rendered := stdout.String()
-	assertContains(t, rendered, "## Example yaml document")
-	assertContains(t, rendered, "```yaml")
-	assertContains(t, rendered, "name: demo")
-	assertContains(t, rendered, "enabled: true")
-	assertNotContains(t, rendered, "mode: safe")
+	assertContains(t, stderr.String(), "invalid uri mapping")
 }
 
 func TestRunMod2SchemaWritesSchemaToStdout(t *testing.T) {
@@ -360,6 +1170,27 @@ func TestRunMod2SchemaWritesSchemaToStdout(t *testing.T) {
 	}
 }
 
+func TestRunMod2SchemaMatchesEmbeddedProjectSchema(t *testing.T) {
+	t.Parallel()
+
+	moduleRoot := findModuleRoot(t)
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := run([]string{"mod2schema", "--module-root", moduleRoot, "--type", "Project", testModulePath}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	embedded, err := os.ReadFile(filepath.Join(moduleRoot, "project.schema.json"))
+	if err != nil {
+		t.Fatalf("read embedded project schema: %v", err)
+	}
+
+	if stdout.String() != string(embedded) {
+		t.Fatal("project.schema.json is out of date with Project type; run make example")
+	}
+}
+
 func TestRunMod2SchemaWritesSchemaToOutputFile(t *testing.T) {
 	t.Parallel()
 
@@ -432,6 +1263,32 @@ func TestRunMod2MarkdownWritesToOutputFile(t *testing.T) {
 	}
 }
 
+func TestRunSchemaToMarkdownAcceptsYAMLSchema(t *testing.T) {
+	t.Parallel()
+
+	schemaPath := filepath.Join(t.TempDir(), "schema.yaml")
+	if err := os.WriteFile(schemaPath, []byte(`$schema: https://json-schema.org/draft/2020-12/schema
+type: object
+properties:
+  name:
+    type: string
+    default: demo
+`), 0o600); err != nil {
+		t.Fatalf("write schema: %v", err)
+	}
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	code := run([]string{"schema2md", "--format", "json", schemaPath}, &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("run exit code = %d, stderr: %s", code, stderr.String())
+	}
+
+	assertNotContains(t, stderr.String(), "warning")
+	assertContains(t, stdout.String(), "### Root.name")
+	assertContains(t, stdout.String(), `"name": "demo"`)
+}
+
 func TestRunWarnsOnUnknownDraft(t *testing.T) {
 	t.Parallel()
 
@@ -608,6 +1465,48 @@ func writeSchemaExampleFixture(t *testing.T) string {
 	return path
 }
 
+func writeMultiFileSchemaFixture(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "common"), 0o750); err != nil {
+		t.Fatalf("create fixture dir: %v", err)
+	}
+
+	tlsBody := `{
+  "$defs": {
+    "TLS": {
+      "type": "object",
+      "properties": {
+        "cert_file": { "type": "string" }
+      }
+    }
+  }
+}`
+	if err := os.WriteFile(filepath.Join(dir, "common", "tls.json"), []byte(tlsBody), 0o600); err != nil {
+		t.Fatalf("write schema fixture: %v", err)
+	}
+
+	path := filepath.Join(dir, "schema.json")
+	body := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$ref": "#/$defs/Config",
+  "$defs": {
+    "Config": {
+      "type": "object",
+      "properties": {
+        "tls": { "$ref": "common/tls.json#/$defs/TLS" }
+      }
+    }
+  }
+}`
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("write schema fixture: %v", err)
+	}
+
+	return path
+}
+
 func assertContains(t *testing.T, haystack, needle string) {
 	t.Helper()
 
@@ -623,3 +1522,89 @@ func assertNotContains(t *testing.T, haystack, needle string) {
 		t.Fatalf("unexpected substring %q in:\n%s", needle, haystack)
 	}
 }
+
+// lockedBuffer is bytes.Buffer safe for writes of watch loop and reads of test.
+type lockedBuffer struct {
+	buffer bytes.Buffer
+	mutex  sync.Mutex
+}
+
+func (buffer *lockedBuffer) Write(data []byte) (int, error) {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+
+	return buffer.buffer.Write(data)
+}
+
+func (buffer *lockedBuffer) String() string {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+
+	return buffer.buffer.String()
+}
+
+// startWatchRunner runs CLI watch command in background; stop cancels it and returns exit code.
+func startWatchRunner(t *testing.T, args ...string) (*lockedBuffer, func() int) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stderr := &lockedBuffer{}
+	runner := &cliRunner{
+		watchContext: ctx,
+		programName:  "schemadoc",
+		stdin:        strings.NewReader(""),
+		stdout:       &lockedBuffer{},
+		stderr:       stderr,
+	}
+
+	done := make(chan int, 1)
+	go func() {
+		done <- runner.run(args)
+	}()
+
+	stopped := false
+	stop := func() int {
+		stopped = true
+		cancel()
+		return <-done
+	}
+
+	t.Cleanup(func() {
+		if !stopped {
+			stop()
+		}
+	})
+
+	return stderr, stop
+}
+
+// waitForWatch polls condition until it holds or test times out.
+func waitForWatch(t *testing.T, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("watch condition was not met in time")
+		}
+
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func writeWatchedFile(t *testing.T, path, body string) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+}
+
+func readWatchedFile(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
@@ -0,0 +1,308 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/woozymasta/schemadoc"
+)
+
+var (
+	// errWatchInput is returned when watch mode reads schema from stdin.
+	errWatchInput = errors.New("--watch requires input schema file")
+	// errWatchCheck is returned when watch mode is combined with drift check.
+	errWatchCheck = errors.New("--watch and --check are mutually exclusive")
+	// errWatchInterval is returned when polling interval is not positive.
+	errWatchInterval = errors.New("--watch-interval must be positive")
+)
+
+// fileDigest is content hash of watched file; missing and unreadable files have zero digest.
+type fileDigest [sha256.Size]byte
+
+// watchBuild regenerates outputs once and returns files affecting them, also when generation fails.
+type watchBuild func() ([]string, error)
+
+// watch runs build and repeats it whenever content of returned files changes, until interrupted.
+//
+// Files are polled, so watching works on every platform without notify libraries. Content is
+// compared instead of modification time, so rewriting identical file does not trigger build.
+// Build errors are reported to stderr and watching goes on.
+func (runner *cliRunner) watch(flags watchFlags, build watchBuild) error {
+	if flags.Interval <= 0 {
+		return errWatchInterval
+	}
+
+	ctx := runner.watchContext
+	if ctx == nil {
+		var stop context.CancelFunc
+		ctx, stop = signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+	}
+
+	files, err := build()
+	files = mergeWatchFiles(nil, files, err)
+	digests := digestFiles(files)
+	runner.reportWatch(nil, err)
+	_, _ = fmt.Fprintf(runner.stderr, "watching %d files for changes; press Ctrl+C to stop\n", len(files))
+
+	ticker := time.NewTicker(flags.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		current := digestFiles(files)
+		changed := changedFiles(digests, current)
+		if len(changed) == 0 {
+			continue
+		}
+
+		current, ok := settleFiles(ctx, files, current, flags.Debounce)
+		if !ok {
+			return nil
+		}
+
+		next, err := build()
+		files = mergeWatchFiles(files, next, err)
+		digests = current
+		for _, file := range files {
+			if _, known := digests[file]; !known {
+				digests[file] = digestFile(file)
+			}
+		}
+
+		runner.reportWatch(changed, err)
+	}
+}
+
+// reportWatch writes changed files and build result of one watch iteration to stderr.
+func (runner *cliRunner) reportWatch(changed []string, err error) {
+	if len(changed) > 0 {
+		_, _ = fmt.Fprintf(runner.stderr, "changed: %s\n", strings.Join(changed, ", "))
+	}
+
+	switch {
+	case err != nil:
+		_, _ = fmt.Fprintf(runner.stderr, "error: %v\n", err)
+	case len(changed) > 0:
+		_, _ = fmt.Fprintln(runner.stderr, "regenerated")
+	}
+}
+
+// settleFiles waits until files keep same content for debounce period and returns their digests.
+//
+// It reports false when watch is interrupted while waiting.
+func settleFiles(ctx context.Context, files []string, digests map[string]fileDigest, debounce time.Duration) (map[string]fileDigest, bool) {
+	for debounce > 0 {
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-time.After(debounce):
+		}
+
+		next := digestFiles(files)
+		if maps.Equal(next, digests) {
+			break
+		}
+
+		digests = next
+	}
+
+	return digests, true
+}
+
+// mergeWatchFiles returns cleaned, sorted and unique watched files of build.
+//
+// Failed build may stop before discovering every file, so previous files are kept on error;
+// build must return its error for that, otherwise files it did not reach are dropped from watching.
+func mergeWatchFiles(previous, next []string, err error) []string {
+	files := make([]string, 0, len(previous)+len(next))
+	if err != nil {
+		files = append(files, previous...)
+	}
+
+	for _, file := range next {
+		if strings.TrimSpace(file) != "" {
+			files = append(files, filepath.Clean(file))
+		}
+	}
+
+	slices.Sort(files)
+	return slices.Compact(files)
+}
+
+// digestFiles returns content digests of files.
+func digestFiles(files []string) map[string]fileDigest {
+	digests := make(map[string]fileDigest, len(files))
+	for _, file := range files {
+		digests[file] = digestFile(file)
+	}
+
+	return digests
+}
+
+// digestFile returns content digest of file, or zero digest when file cannot be read.
+func digestFile(file string) fileDigest {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return fileDigest{}
+	}
+
+	return sha256.Sum256(data)
+}
+
+// changedFiles returns sorted files whose digest differs between snapshots.
+func changedFiles(previous, current map[string]fileDigest) []string {
+	var changed []string
+	for file, digest := range current {
+		if previous[file] != digest {
+			changed = append(changed, file)
+		}
+	}
+
+	slices.Sort(changed)
+	return changed
+}
+
+// watchSchemaToMarkdown regenerates schema-to-markdown output on change of schema, references or template.
+func (runner *cliRunner) watchSchemaToMarkdown(options markdownOptions, inputPath, outputPath string) error {
+	inputPath = strings.TrimSpace(inputPath)
+	if inputPath == "" {
+		return errWatchInput
+	}
+
+	return runner.watch(options.Watch, func() ([]string, error) {
+		files := []string{inputPath}
+		if options.TemplatePath != "" {
+			files = append(files, options.TemplatePath)
+		}
+
+		schemaBytes, sourcePath, err := runner.readSchemaInput(inputPath)
+		if err != nil {
+			return files, fmt.Errorf("read schema input: %w", err)
+		}
+
+		loader, err := resolveSchemaLoader(options.URIMappings)
+		if err != nil {
+			return files, err
+		}
+
+		references, err := referencedFiles(schemaBytes, schemadoc.Options{
+			SourcePath: sourcePath,
+			BaseDir:    sourceBaseDir(sourcePath),
+			Loader:     loader,
+		})
+
+		files = append(files, references...)
+		if renderErr := runner.runSchemaToMarkdownBytes(options, schemaBytes, sourcePath, outputPath); renderErr != nil {
+			return files, renderErr
+		}
+
+		return files, err
+	})
+}
+
+// watchGenerate reruns selected project jobs on change of project file or job input files.
+func (runner *cliRunner) watchGenerate(projectPath string, jobNames []string, flags watchFlags) error {
+	projectPath, err := resolveProjectPath(projectPath)
+	if err != nil {
+		return err
+	}
+
+	return runner.watch(flags, func() ([]string, error) {
+		files, err := projectWatchFiles(projectPath, jobNames)
+		if generateErr := runner.runGenerate(projectPath, jobNames, false); generateErr != nil {
+			return files, generateErr
+		}
+
+		return files, err
+	})
+}
+
+// projectWatchFiles returns project file with schema, referenced and template files of selected jobs.
+//
+// Module jobs contribute template files only; Go sources of reflected types are not watched.
+// Reference errors are joined and returned together with files discovered so far.
+func projectWatchFiles(projectPath string, jobNames []string) ([]string, error) {
+	files := []string{projectPath}
+	project, err := schemadoc.LoadProjectFile(projectPath)
+	if err != nil {
+		return files, nil
+	}
+
+	jobs, err := selectProjectJobs(project.Jobs, jobNames)
+	if err != nil {
+		return files, nil
+	}
+
+	var errs []error
+
+	projectDir := filepath.Dir(projectPath)
+	for _, job := range jobs {
+		if job.TemplateFile != "" {
+			files = append(files, projectFilePath(projectDir, job.TemplateFile))
+		}
+
+		if job.Schema == "" {
+			continue
+		}
+
+		schemaPath := projectFilePath(projectDir, job.Schema)
+		files = append(files, schemaPath)
+
+		schemaBytes, err := os.ReadFile(schemaPath)
+		if err != nil {
+			continue
+		}
+
+		renderOptions, err := projectRenderOptions(job, projectDir, schemaPath)
+		if err != nil {
+			continue
+		}
+
+		references, err := referencedFiles(schemaBytes, renderOptions)
+		files = append(files, references...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("job %s: %w", projectJobLabel(job), err))
+		}
+	}
+
+	return files, errors.Join(errs...)
+}
+
+// referencedFiles returns local files of external documents referenced from schema.
+//
+// Absolute URIs are watched when URI mappings resolve them to files; other URIs are not watched.
+// On error it returns files discovered so far together with the error, including the broken reference.
+func referencedFiles(schemaBytes []byte, renderOptions schemadoc.Options) ([]string, error) {
+	documents, err := schemadoc.ReferencedDocuments(schemaBytes, renderOptions)
+	if err != nil {
+		err = fmt.Errorf("resolve schema references: %w", err)
+	}
+
+	files := make([]string, 0, len(documents))
+	for _, location := range documents {
+		if filepath.IsAbs(location) {
+			files = append(files, location)
+		}
+	}
+
+	return files, err
+}
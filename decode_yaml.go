@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// isYAMLSchema reports whether schema bytes should be decoded as YAML instead of JSON.
+//
+// JSON documents start with `{` or `[` after optional BOM and whitespace;
+// everything else (block mappings, comments, `---` markers) is treated as YAML.
+// Flow-style YAML also starts with `{` or `[` and is decoded after JSON decoding fails.
+func isYAMLSchema(schemaBytes []byte) bool {
+	trimmed := bytes.TrimSpace(bytes.TrimPrefix(schemaBytes, []byte("\xef\xbb\xbf")))
+	if len(trimmed) == 0 {
+		return false
+	}
+
+	return trimmed[0] != '{' && trimmed[0] != '['
+}
+
+// decodeYAMLSchema decodes first YAML document into JSON-like value tree.
+//
+// Numbers become json.Number, mapping keys become strings and errors carry YAML line and column.
+func decodeYAMLSchema(schemaBytes []byte, order keyOrder) (any, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(schemaBytes, &document); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDecodeSchema, err)
+	}
+
+	if document.Kind == 0 {
+		return nil, fmt.Errorf("%w: empty yaml document", ErrDecodeSchema)
+	}
+
+	value, err := yamlNodeValue(&document, order)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDecodeSchema, err)
+	}
+
+	return value, nil
+}
+
+// yamlNodeValue converts YAML node into map[string]any, []any and scalar values.
+func yamlNodeValue(node *yaml.Node, order keyOrder) (any, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+
+		return yamlNodeValue(node.Content[0], order)
+	case yaml.AliasNode:
+		return yamlNodeValue(node.Alias, order)
+	case yaml.SequenceNode:
+		out := make([]any, 0, len(node.Content))
+		for _, item := range node.Content {
+			value, err := yamlNodeValue(item, order)
+			if err != nil {
+				return nil, err
+			}
+
+			out = append(out, value)
+		}
+
+		return out, nil
+	case yaml.MappingNode:
+		out := make(map[string]any, len(node.Content)/2)
+		keys, err := mergeYAMLMapping(out, node, order)
+		if err != nil {
+			return nil, err
+		}
+
+		order.record(out, keys)
+		return out, nil
+	case yaml.ScalarNode:
+		return yamlScalarValue(node)
+	default:
+		return nil, yamlNodeError(node, "unsupported yaml node kind %d", node.Kind)
+	}
+}
+
+// mergeYAMLMapping copies mapping entries into out, expanding `<<` merge keys,
+// and returns keys in declaration order.
+//
+// Explicit keys override merged keys; duplicate explicit keys are rejected.
+func mergeYAMLMapping(out map[string]any, node *yaml.Node, order keyOrder) ([]string, error) {
+	explicit := make(map[string]struct{}, len(node.Content)/2)
+	keys := make([]string, 0, len(node.Content)/2)
+	for index := 0; index+1 < len(node.Content); index += 2 {
+		keyNode, valueNode := node.Content[index], node.Content[index+1]
+		if keyNode.Kind == yaml.ScalarNode && keyNode.ShortTag() == "!!merge" {
+			merged, err := mergeYAMLSources(out, valueNode, order)
+			if err != nil {
+				return nil, err
+			}
+
+			keys = append(keys, merged...)
+			continue
+		}
+
+		if keyNode.Kind != yaml.ScalarNode {
+			return nil, yamlNodeError(keyNode, "mapping key must be scalar")
+		}
+
+		key := keyNode.Value
+		if _, exists := explicit[key]; exists {
+			return nil, yamlNodeError(keyNode, "duplicate mapping key %q", key)
+		}
+
+		value, err := yamlNodeValue(valueNode, order)
+		if err != nil {
+			return nil, err
+		}
+
+		explicit[key] = struct{}{}
+		if _, merged := out[key]; !merged {
+			keys = append(keys, key)
+		}
+
+		out[key] = value
+	}
+
+	return keys, nil
+}
+
+// mergeYAMLSources applies `<<` merge value (mapping or sequence of mappings) without overriding keys
+// and returns newly added keys in declaration order.
+func mergeYAMLSources(out map[string]any, node *yaml.Node, order keyOrder) ([]string, error) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	sources := []*yaml.Node{node}
+	if node.Kind == yaml.SequenceNode {
+		sources = node.Content
+	}
+
+	var added []string
+	for _, source := range sources {
+		if source.Kind == yaml.AliasNode {
+			source = source.Alias
+		}
+
+		if source.Kind != yaml.MappingNode {
+			return nil, yamlNodeError(source, "merge value must be mapping")
+		}
+
+		merged := make(map[string]any)
+		keys, err := mergeYAMLMapping(merged, source, order)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range keys {
+			if _, exists := out[key]; !exists {
+				out[key] = merged[key]
+				added = append(added, key)
+			}
+		}
+	}
+
+	return added, nil
+}
+
+// yamlScalarValue converts YAML scalar into JSON-compatible value keeping number text as json.Number.
+func yamlScalarValue(node *yaml.Node) (any, error) {
+	switch node.ShortTag() {
+	case "!!null":
+		return nil, nil
+	case "!!bool":
+		var value bool
+		if err := node.Decode(&value); err != nil {
+			return nil, yamlNodeError(node, "%v", err)
+		}
+
+		return value, nil
+	case "!!int":
+		if json.Valid([]byte(node.Value)) {
+			return json.Number(node.Value), nil
+		}
+
+		var value int64
+		if err := node.Decode(&value); err != nil {
+			return nil, yamlNodeError(node, "%v", err)
+		}
+
+		return json.Number(strconv.FormatInt(value, 10)), nil
+	case "!!float":
+		if json.Valid([]byte(node.Value)) {
+			return json.Number(node.Value), nil
+		}
+
+		var value float64
+		if err := node.Decode(&value); err != nil {
+			return nil, yamlNodeError(node, "%v", err)
+		}
+
+		if math.IsInf(value, 0) || math.IsNaN(value) {
+			return nil, yamlNodeError(node, "number %q is not representable in JSON", node.Value)
+		}
+
+		return json.Number(strconv.FormatFloat(value, 'g', -1, 64)), nil
+	default:
+		return node.Value, nil
+	}
+}
+
+// yamlNodeError returns error prefixed with YAML node line and column.
+func yamlNodeError(node *yaml.Node, format string, args ...any) error {
+	return fmt.Errorf("yaml: line %d, column %d: %s", node.Line, node.Column, fmt.Sprintf(format, args...))
+}
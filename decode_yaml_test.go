@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// yamlSchemaFixture is YAML-authored schema with anchors, merge keys and numeric keywords.
+const yamlSchemaFixture = `
+# Service configuration schema.
+$schema: https://json-schema.org/draft/2020-12/schema
+$ref: "#/$defs/Config"
+$defs:
+  Config:
+    type: object
+    required: [port]
+    properties:
+      port: &port
+        type: integer
+        minimum: 1
+        maximum: 65535
+        default: 8080
+      ratio:
+        type: number
+        multipleOf: 0.125
+        default: 1.50
+      admin_port:
+        <<: *port
+        default: 9090
+      200:
+        type: string
+        default: ok
+`
+
+func TestRenderAcceptsYAMLSchema(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render([]byte(yamlSchemaFixture), Options{})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "## Config")
+	assertContains(t, rendered, "### Config.port")
+	assertContains(t, rendered, "### Config.200")
+	assertContains(t, rendered, "between 1 and 65535 (inclusive)")
+	assertContains(t, rendered, "multiple of 0.125")
+}
+
+func TestGenerateExampleJSONAcceptsYAMLSchema(t *testing.T) {
+	t.Parallel()
+
+	data, err := GenerateExampleJSON([]byte(yamlSchemaFixture), ExampleModeAll)
+	if err != nil {
+		t.Fatalf("GenerateExampleJSON: %v", err)
+	}
+
+	assertContains(t, string(data), `"port": 8080`)
+	assertContains(t, string(data), `"admin_port": 9090`)
+	assertContains(t, string(data), `"ratio": 1.50`)
+	assertContains(t, string(data), `"200": "ok"`)
+}
+
+func TestDecodeYAMLSchemaKeepsNumberText(t *testing.T) {
+	t.Parallel()
+
+	root, err := decodeSchema([]byte("minimum: 0x10\nmaximum: 1e3\nmultipleOf: .5\n"), nil)
+	if err != nil {
+		t.Fatalf("decodeSchema: %v", err)
+	}
+
+	object, _ := root.(map[string]any)
+	for key, want := range map[string]json.Number{"minimum": "16", "maximum": "1e3", "multipleOf": "0.5"} {
+		if got := object[key]; got != want {
+			t.Fatalf("%s = %#v, want %#v", key, got, want)
+		}
+	}
+}
+
+func TestRenderAcceptsFlowStyleYAMLSchema(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render([]byte("{type: object, properties: {name: {type: string, default: demo}, port: {type: integer}}}\n"), Options{})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "### Root.name")
+	assertContains(t, rendered, "### Root.port")
+	if strings.Index(rendered, "### Root.port") < strings.Index(rendered, "### Root.name") {
+		t.Fatalf("flow mapping key order was not kept:\n%s", rendered)
+	}
+
+	_, err = Render([]byte(`{"type": "object"`), Options{})
+	if !errors.Is(err, ErrDecodeSchema) {
+		t.Fatalf("expected ErrDecodeSchema, got %v", err)
+	}
+
+	assertContains(t, err.Error(), "unexpected end of JSON input")
+}
+
+func TestDecodeYAMLSchemaReportsPosition(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		input string
+		want  string
+	}{
+		"syntax": {
+			input: "type: object\nproperties:\n  name: [string\n",
+			want:  "yaml: line 2",
+		},
+		"duplicate key": {
+			input: "type: object\ntype: string\n",
+			want:  "line 2, column 1",
+		},
+		"not a number": {
+			input: "type: number\nmaximum: .inf\n",
+			want:  "line 2, column 10",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Render([]byte(tc.input), Options{})
+			if !errors.Is(err, ErrDecodeSchema) {
+				t.Fatalf("expected ErrDecodeSchema, got: %v", err)
+			}
+
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("error %q does not contain %q", err.Error(), tc.want)
+			}
+		})
+	}
+}
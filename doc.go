@@ -3,11 +3,12 @@
 // Source: github.com/woozymasta/schemadoc
 
 /*
-Package schemadoc renders CommonMark documentation from JSON Schema documents.
+Package schemadoc renders CommonMark, HTML, AsciiDoc, reStructuredText and man page
+documentation from JSON Schema documents.
 
 The package focuses on deterministic markdown output for generated schemas and
-project configuration models. It supports built-in templates ("list", "table")
-and custom template text.
+project configuration models. It supports built-in templates ("list", "table",
+"html", "asciidoc", "rst", "man") and custom template text.
 
 Basic render from schema bytes:
 
@@ -39,6 +40,27 @@ Render directly from file:
 
 	fmt.Println(md)
 
+Relative file references such as "common/tls.json#/$defs/TLS" are resolved
+from Options.BaseDir (RenderFile uses the schema file directory) and merged
+into local definitions. Write the merged schema with Bundle:
+
+	bundled, err := schemadoc.BundleFile("schema.json", schemadoc.Options{})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(bundled))
+
+Absolute URI references are loaded through Options.Loader. MapLoader maps URI
+prefixes to local directories or fs.FS values for offline builds:
+
+	md, err = schemadoc.RenderFile("schema.json", schemadoc.Options{
+		Loader: schemadoc.NewMapLoader().MapDir("https://example.com/schemas/", "vendor/schemas"),
+	})
+	if err != nil {
+		return err
+	}
+
 Use built-in templates:
 
 	names := schemadoc.BuiltinTemplateNames()
@@ -65,6 +87,80 @@ Generate example payload from schema:
 
 	fmt.Println(string(jsonExample))
 
+Render standalone HTML page with embedded stylesheet:
+
+	page, err := schemadoc.Render(schemaBytes, schemadoc.Options{
+		Format: schemadoc.OutputFormatHTML,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(page)
+
+Render AsciiDoc or reStructuredText; markdown in descriptions is converted
+to the target syntax:
+
+	adoc, err := schemadoc.Render(schemaBytes, schemadoc.Options{
+		Format: schemadoc.OutputFormatAsciiDoc,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(adoc)
+
+Render section 5 man page for configuration file:
+
+	page, err := schemadoc.Render(schemaBytes, schemadoc.Options{
+		Format:    schemadoc.OutputFormatMan,
+		Title:     "myapp.conf",
+		ManSource: "myapp 1.4.0",
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(page)
+
+Split documentation into index page and one linked page per definition:
+
+	pages, err := schemadoc.RenderPages(schemaBytes, schemadoc.Options{
+		IndexPage: "README.md",
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, page := range pages {
+		if err := os.WriteFile(filepath.Join("docs", page.Path), []byte(page.Content), 0o600); err != nil {
+			return err
+		}
+	}
+
+Render definition reference graph as Graphviz DOT, two reference hops deep:
+
+	dot, err := schemadoc.RenderDOT(schemaBytes, schemadoc.Options{
+		DiagramDepth: 2,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(dot)
+
+Build documentation view model for non-Go tooling; every text value
+carries markdown-escaped and raw form:
+
+	view, err := schemadoc.BuildView(schemaBytes, schemadoc.Options{})
+	if err != nil {
+		return err
+	}
+
+	for _, definition := range view.Definitions {
+		fmt.Println(definition.Anchor, definition.Name.Raw)
+	}
+
 Enable embedded example block in markdown template output:
 
 	md, err := schemadoc.Render(schemaBytes, schemadoc.Options{
@@ -11,11 +11,19 @@ var (
 	ErrReadSchemaFile = errors.New("read schema file")
 	// ErrExecuteMarkdownTemplate is returned when markdown template execution fails.
 	ErrExecuteMarkdownTemplate = errors.New("execute markdown template")
+	// ErrExecuteTemplate is returned when AsciiDoc or reStructuredText template execution fails.
+	ErrExecuteTemplate = errors.New("execute template")
+	// ErrExecuteHTMLTemplate is returned when HTML template execution fails.
+	ErrExecuteHTMLTemplate = errors.New("execute html template")
+	// ErrUnknownOutputFormat is returned when requested output format is not supported.
+	ErrUnknownOutputFormat = errors.New("unknown output format")
+	// ErrTemplateFormatMismatch is returned when built-in template does not produce requested output format.
+	ErrTemplateFormatMismatch = errors.New("template format mismatch")
 	// ErrUnknownBuiltinTemplate is returned when requested built-in template name is not registered.
 	ErrUnknownBuiltinTemplate = errors.New("unknown built-in template")
 	// ErrReadBuiltinTemplate is returned when built-in template file loading fails.
 	ErrReadBuiltinTemplate = errors.New("read built-in template")
-	// ErrDecodeSchema is returned when schema JSON decoding fails.
+	// ErrDecodeSchema is returned when schema JSON or YAML decoding fails.
 	ErrDecodeSchema = errors.New("decode schema")
 	// ErrSchemaRootType is returned when schema root is not object or boolean.
 	ErrSchemaRootType = errors.New("schema root must be object or boolean")
@@ -25,8 +33,38 @@ var (
 	ErrUnknownExampleMode = errors.New("unknown example mode")
 	// ErrUnknownExampleFormat is returned when example generation format is not supported.
 	ErrUnknownExampleFormat = errors.New("unknown example format")
+	// ErrUnknownPropertyOrder is returned when property order mode is not supported.
+	ErrUnknownPropertyOrder = errors.New("unknown property order")
 	// ErrEncodeExampleJSON is returned when generated example JSON encoding fails.
 	ErrEncodeExampleJSON = errors.New("encode example json")
 	// ErrEncodeExampleYAML is returned when generated example YAML encoding fails.
 	ErrEncodeExampleYAML = errors.New("encode example yaml")
+	// ErrLoadSchemaReference is returned when externally referenced schema file cannot be loaded.
+	ErrLoadSchemaReference = errors.New("load schema reference")
+	// ErrResolveSchemaReference is returned when external `$ref` target cannot be resolved.
+	ErrResolveSchemaReference = errors.New("resolve schema reference")
+	// ErrLoadSchemaURI is returned when Loader cannot load schema document for URI.
+	ErrLoadSchemaURI = errors.New("load schema uri")
+	// ErrUnknownRootDefinition is returned when requested root definition does not exist.
+	ErrUnknownRootDefinition = errors.New("unknown root definition")
+	// ErrEncodeSchemaJSON is returned when bundled schema JSON encoding fails.
+	ErrEncodeSchemaJSON = errors.New("encode schema json")
+	// ErrPagesFormat is returned when multi-page output is requested for format other than markdown.
+	ErrPagesFormat = errors.New("multi-page output requires markdown format")
+	// ErrInvalidPageName is returned when page file name pattern or index page name is invalid.
+	ErrInvalidPageName = errors.New("invalid page name")
+	// ErrUnknownDiagramKind is returned when requested Mermaid diagram kind is not supported.
+	ErrUnknownDiagramKind = errors.New("unknown diagram kind")
+	// ErrEncodeViewJSON is returned when view model JSON encoding fails.
+	ErrEncodeViewJSON = errors.New("encode view json")
+	// ErrInvalidDefinitionPattern is returned when definition include or exclude glob is malformed.
+	ErrInvalidDefinitionPattern = errors.New("invalid definition pattern")
+	// ErrInjectionBlockNotFound is returned when target document has no begin marker of requested block.
+	ErrInjectionBlockNotFound = errors.New("injection block not found")
+	// ErrInjectionMarker is returned when injection block markers or begin marker options are malformed.
+	ErrInjectionMarker = errors.New("invalid injection marker")
+	// ErrReadProjectFile is returned when project file loading fails.
+	ErrReadProjectFile = errors.New("read project file")
+	// ErrInvalidProject is returned when project file does not match project schema or job rules.
+	ErrInvalidProject = errors.New("invalid project file")
 )
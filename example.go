@@ -9,6 +9,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"os"
+	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -48,60 +51,86 @@ var exampleScalarPlaceholders = map[string]any{
 type exampleBuilder struct {
 	activeRefs map[string]int
 	mode       ExampleMode
+	order      PropertyOrder
 	doc        schemaDocument
 }
 
 // GenerateExampleJSON returns generated example payload encoded as pretty JSON.
 func GenerateExampleJSON(schemaBytes []byte, mode ExampleMode) ([]byte, error) {
-	value, err := generateExampleValue(schemaBytes, mode)
+	doc, err := loadDocument(schemaBytes, Options{})
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := marshalExampleJSON(value)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %w", ErrEncodeExampleJSON, err)
-	}
-
-	return data, nil
+	return generateExampleJSON(doc, mode, PropertyOrderSource)
 }
 
 // GenerateExampleYAML returns generated example payload encoded as YAML.
 func GenerateExampleYAML(schemaBytes []byte, mode ExampleMode) ([]byte, error) {
-	mode, err := normalizeExampleMode(mode)
+	doc, err := loadDocument(schemaBytes, Options{})
 	if err != nil {
 		return nil, err
 	}
 
-	doc, err := parseDocument(schemaBytes)
+	return generateExampleYAML(doc, mode, PropertyOrderSource)
+}
+
+// GenerateExample returns generated example payload encoded in selected format.
+func GenerateExample(schemaBytes []byte, mode ExampleMode, format ExampleFormat) ([]byte, error) {
+	format, err := normalizeExampleFormat(format)
 	if err != nil {
 		return nil, err
 	}
 
-	builder := exampleBuilder{
-		doc:        doc,
-		mode:       mode,
-		activeRefs: make(map[string]int),
+	doc, err := loadDocument(schemaBytes, Options{})
+	if err != nil {
+		return nil, err
 	}
 
-	value := builder.buildNode(doc.Root)
-	rootNode, err := yamlNodeForValue(value)
+	return generateExampleDocument(doc, mode, format, PropertyOrderSource)
+}
+
+// GenerateExampleFile reads schema from file and returns generated example payload.
+//
+// Mode and format are taken from Options.ExampleMode and Options.ExampleFormat.
+// Relative file references are resolved from the schema file directory.
+func GenerateExampleFile(path string, opt Options) ([]byte, error) {
+	schemaBytes, err := os.ReadFile(path)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %w", ErrEncodeExampleYAML, err)
+		return nil, fmt.Errorf("%w: %w", ErrReadSchemaFile, err)
 	}
 
-	builder.annotateYAMLNode(rootNode, doc.Root)
+	if strings.TrimSpace(opt.SourcePath) == "" {
+		opt.SourcePath = path
+	}
 
-	data, err := marshalExampleYAMLNode(rootNode)
+	if strings.TrimSpace(opt.BaseDir) == "" {
+		opt.BaseDir = filepath.Dir(path)
+	}
+
+	return GenerateExampleWithOptions(schemaBytes, opt)
+}
+
+// GenerateExampleWithOptions returns generated example payload using render Options.
+//
+// Mode and format are taken from Options.ExampleMode and Options.ExampleFormat;
+// empty mode defaults to `all`.
+func GenerateExampleWithOptions(schemaBytes []byte, opt Options) ([]byte, error) {
+	format, err := normalizeExampleFormat(opt.ExampleFormat)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %w", ErrEncodeExampleYAML, err)
+		return nil, err
 	}
 
-	return data, nil
+	doc, err := loadDocument(schemaBytes, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return generateExampleDocument(doc, exampleModeOrDefault(opt.ExampleMode), format, opt.PropertyOrder)
 }
 
-// GenerateExample returns generated example payload encoded in selected format.
-func GenerateExample(schemaBytes []byte, mode ExampleMode, format ExampleFormat) ([]byte, error) {
+// generateExampleDocument builds example payload for parsed document in selected format.
+func generateExampleDocument(doc schemaDocument, mode ExampleMode, format ExampleFormat, order PropertyOrder) ([]byte, error) {
 	format, err := normalizeExampleFormat(format)
 	if err != nil {
 		return nil, err
@@ -109,33 +138,79 @@ func GenerateExample(schemaBytes []byte, mode ExampleMode, format ExampleFormat)
 
 	switch format {
 	case ExampleFormatJSON:
-		return GenerateExampleJSON(schemaBytes, mode)
+		return generateExampleJSON(doc, mode, order)
 	case ExampleFormatYAML:
-		return GenerateExampleYAML(schemaBytes, mode)
+		return generateExampleYAML(doc, mode, order)
 	default:
 		return nil, fmt.Errorf("%w %q", ErrUnknownExampleFormat, format)
 	}
 }
 
-// generateExampleValue parses schema and builds example value for selected mode.
-func generateExampleValue(schemaBytes []byte, mode ExampleMode) (any, error) {
+// generateExampleJSON builds example payload for parsed document encoded as pretty JSON.
+func generateExampleJSON(doc schemaDocument, mode ExampleMode, order PropertyOrder) ([]byte, error) {
+	builder, err := newExampleBuilder(doc, mode, order)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := marshalExampleJSON(builder.buildNode(doc.Root))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrEncodeExampleJSON, err)
+	}
+
+	return data, nil
+}
+
+// generateExampleYAML builds example payload for parsed document encoded as commented YAML.
+func generateExampleYAML(doc schemaDocument, mode ExampleMode, order PropertyOrder) ([]byte, error) {
+	builder, err := newExampleBuilder(doc, mode, order)
+	if err != nil {
+		return nil, err
+	}
+
+	value := builder.buildNode(doc.Root)
+	rootNode, err := yamlNodeForValue(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrEncodeExampleYAML, err)
+	}
+
+	builder.annotateYAMLNode(rootNode, doc.Root)
+
+	data, err := marshalExampleYAMLNode(rootNode)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrEncodeExampleYAML, err)
+	}
+
+	return data, nil
+}
+
+// newExampleBuilder validates mode and property order and prepares example builder for parsed document.
+func newExampleBuilder(doc schemaDocument, mode ExampleMode, order PropertyOrder) (*exampleBuilder, error) {
 	mode, err := normalizeExampleMode(mode)
 	if err != nil {
 		return nil, err
 	}
 
-	doc, err := parseDocument(schemaBytes)
+	order, err = normalizePropertyOrder(order)
 	if err != nil {
 		return nil, err
 	}
 
-	builder := exampleBuilder{
+	return &exampleBuilder{
 		doc:        doc,
 		mode:       mode,
+		order:      order,
 		activeRefs: make(map[string]int),
+	}, nil
+}
+
+// exampleModeOrDefault returns `all` mode for empty caller value.
+func exampleModeOrDefault(mode ExampleMode) ExampleMode {
+	if strings.TrimSpace(string(mode)) == "" {
+		return ExampleModeAll
 	}
 
-	return builder.buildNode(doc.Root), nil
+	return mode
 }
 
 // normalizeExampleMode validates and normalizes caller mode value.
@@ -189,10 +264,10 @@ func (builder *exampleBuilder) buildNode(node schemaValue) any {
 // buildFromObject builds example from non-boolean schema object.
 func (builder *exampleBuilder) buildFromObject(object map[string]any) any {
 	schemaType := schemaTypeName(object)
-	properties, required := builder.collectObjectShape(schemaValue{Object: object})
+	properties, required, declared := builder.collectObjectShape(schemaValue{Object: object})
 
 	if schemaType == "object" || len(properties) > 0 || len(required) > 0 {
-		return builder.buildObjectFromShape(properties, required)
+		return builder.buildObjectFromShape(properties, required, declared)
 	}
 
 	if schemaType == "array" || hasArrayShape(object) {
@@ -222,21 +297,21 @@ func (builder *exampleBuilder) buildFromObject(object map[string]any) any {
 	return nil
 }
 
-// buildObjectFromShape materializes object value from collected property shape.
-func (builder *exampleBuilder) buildObjectFromShape(properties map[string]schemaValue, required []string) map[string]any {
-	out := make(map[string]any)
-	if len(properties) == 0 {
-		return out
-	}
+// buildObjectFromShape materializes object value from collected property shape in configured key order.
+func (builder *exampleBuilder) buildObjectFromShape(properties map[string]schemaValue, required, declared []string) orderedObject {
+	out := orderedObject{values: make(map[string]any, len(properties))}
+	for _, key := range propertyOrder(builder.order, declared, required) {
+		property, ok := properties[key]
+		if !ok {
+			continue
+		}
 
-	order := propertyOrder(required, properties)
-	if builder.mode == ExampleModeRequired {
-		order = requiredPropertyOrder(required, properties)
-	}
+		if builder.mode == ExampleModeRequired && !isRequired(required, key) {
+			continue
+		}
 
-	for _, key := range order {
-		value := builder.buildNode(properties[key])
-		out[key] = value
+		out.keys = append(out.keys, key)
+		out.values[key] = builder.buildNode(property)
 	}
 
 	return out
@@ -289,10 +364,10 @@ func (builder *exampleBuilder) buildArrayFromObject(object map[string]any) []any
 	return []any{}
 }
 
-// collectObjectShape returns merged object properties and required keys for node.
-func (builder *exampleBuilder) collectObjectShape(node schemaValue) (map[string]schemaValue, []string) {
+// collectObjectShape returns merged object properties, required keys and property declaration order for node.
+func (builder *exampleBuilder) collectObjectShape(node schemaValue) (map[string]schemaValue, []string, []string) {
 	if node.Object == nil {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	if resolved, release, handled := builder.resolvedObjectForReference(node.Object); handled {
@@ -301,7 +376,7 @@ func (builder *exampleBuilder) collectObjectShape(node schemaValue) (map[string]
 		}
 
 		if resolved == nil {
-			return nil, nil
+			return nil, nil, nil
 		}
 
 		return builder.collectObjectShape(schemaValue{Object: resolved})
@@ -311,22 +386,28 @@ func (builder *exampleBuilder) collectObjectShape(node schemaValue) (map[string]
 }
 
 // collectObjectShapeFromObject merges local properties and allOf object overlays.
-func (builder *exampleBuilder) collectObjectShapeFromObject(object map[string]any) (map[string]schemaValue, []string) {
+func (builder *exampleBuilder) collectObjectShapeFromObject(object map[string]any) (map[string]schemaValue, []string, []string) {
 	properties := mapSchemaValues(object["properties"])
 	required := asStringSlice(object["required"])
 
+	var declared []string
+	if raw, ok := object["properties"].(map[string]any); ok {
+		declared = builder.doc.KeyOrder.keys(raw)
+	}
+
 	for _, raw := range asSlice(object["allOf"]) {
 		schema, ok := toSchemaValue(raw)
 		if !ok {
 			continue
 		}
 
-		nestedProperties, nestedRequired := builder.collectObjectShape(schema)
+		nestedProperties, nestedRequired, nestedDeclared := builder.collectObjectShape(schema)
 		properties = mergePropertySchemas(properties, nestedProperties)
 		required = mergeRequiredKeys(required, nestedRequired)
+		declared = mergeRequiredKeys(declared, nestedDeclared)
 	}
 
-	return properties, required
+	return properties, required, declared
 }
 
 // mergePropertySchemas merges schema property maps while preserving existing keys.
@@ -382,30 +463,6 @@ func mergeRequiredKeys(left, right []string) []string {
 	return out
 }
 
-// requiredPropertyOrder returns deterministic order for required properties only.
-func requiredPropertyOrder(required []string, properties map[string]schemaValue) []string {
-	if len(required) == 0 || len(properties) == 0 {
-		return nil
-	}
-
-	out := make([]string, 0, len(required))
-	seen := make(map[string]struct{}, len(required))
-	for _, key := range required {
-		if _, exists := properties[key]; !exists {
-			continue
-		}
-
-		if _, exists := seen[key]; exists {
-			continue
-		}
-
-		seen[key] = struct{}{}
-		out = append(out, key)
-	}
-
-	return out
-}
-
 // buildCompositionFallback builds value from first schema of oneOf/anyOf/allOf.
 func (builder *exampleBuilder) buildCompositionFallback(object map[string]any) (any, bool) {
 	for _, keyword := range []string{"oneOf", "anyOf", "allOf"} {
@@ -425,7 +482,7 @@ func (builder *exampleBuilder) buildCompositionFallback(object map[string]any) (
 
 // resolvedObjectForReference resolves local ref and merges sibling override keywords.
 func (builder *exampleBuilder) resolvedObjectForReference(object map[string]any) (map[string]any, func(), bool) {
-	ref := asString(object["$ref"])
+	ref := schemaReference(object)
 	if ref == "" {
 		return nil, nil, false
 	}
@@ -608,11 +665,11 @@ func scalarPlaceholder(schemaType string) (any, bool) {
 	return value, ok
 }
 
-// stripReferenceKeyword returns shallow copy without $ref keyword.
+// stripReferenceKeyword returns shallow copy without reference keywords.
 func stripReferenceKeyword(object map[string]any) map[string]any {
 	out := make(map[string]any, len(object))
 	for key, value := range object {
-		if key == "$ref" {
+		if slices.Contains(referenceKeywords, key) {
 			continue
 		}
 
@@ -628,7 +685,7 @@ func mergeSchemaObjects(base, overlay map[string]any) map[string]any {
 	maps.Copy(out, base)
 
 	for key, value := range overlay {
-		if key == "$ref" {
+		if slices.Contains(referenceKeywords, key) {
 			continue
 		}
 
@@ -662,16 +719,7 @@ func cloneJSONValue(value any) any {
 
 // marshalExampleJSON serializes example payload as pretty JSON.
 func marshalExampleJSON(value any) ([]byte, error) {
-	var out bytes.Buffer
-	encoder := json.NewEncoder(&out)
-	encoder.SetEscapeHTML(false)
-	encoder.SetIndent("", "  ")
-
-	if err := encoder.Encode(value); err != nil {
-		return nil, err
-	}
-
-	return out.Bytes(), nil
+	return marshalIndentedJSON(value)
 }
 
 // marshalExampleYAML serializes example payload as YAML.
@@ -899,6 +947,17 @@ func yamlNodeForValue(value any) (*yaml.Node, error) {
 		}
 		return node, nil
 
+	case orderedObject:
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		for _, key := range typed.keys {
+			valueNode, err := yamlNodeForValue(typed.values[key])
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, yamlScalarNode("!!str", key), valueNode)
+		}
+		return node, nil
+
 	case []any:
 		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
 		for _, item := range typed {
@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// injectionMarkerPattern matches `<!-- schemadoc:begin name ... -->` and `<!-- schemadoc:end name -->` comments.
+	injectionMarkerPattern = regexp.MustCompile(`<!--[ \t]*schemadoc:(begin|end)[ \t]+([^\n]*?)[ \t]*-->`)
+	// injectionOptionPattern matches one `key=value` or `key="quoted value"` option of begin marker.
+	injectionOptionPattern = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_-]*)=("(?:[^"\\]|\\.)*"|[^\s"]*)`)
+)
+
+// InjectionBlock is named region of document between schemadoc begin and end markers.
+//
+// Block is delimited by `<!-- schemadoc:begin name key=value -->` and `<!-- schemadoc:end name -->`
+// comments; options of begin marker are `key=value` pairs, values with spaces are double quoted.
+type InjectionBlock struct {
+	// Options holds `key=value` options of begin marker.
+	Options map[string]string `json:"options,omitempty"`
+
+	// Name is block name shared by begin and end markers.
+	Name string `json:"name"`
+
+	// Content is current text between markers.
+	Content string `json:"content"`
+}
+
+// injectionMarker is one begin or end marker found outside fenced code blocks.
+type injectionMarker struct {
+	Kind  string
+	Name  string
+	Rest  string
+	Start int
+	End   int
+}
+
+// FindInjectionBlock returns named block of document with options of its begin marker.
+//
+// Markers inside fenced code blocks are ignored, so documents may show marker syntax in examples.
+func FindInjectionBlock(document []byte, name string) (InjectionBlock, error) {
+	begin, end, err := locateInjectionBlock(string(document), name)
+	if err != nil {
+		return InjectionBlock{}, err
+	}
+
+	options, err := parseInjectionOptions(begin.Rest)
+	if err != nil {
+		return InjectionBlock{}, fmt.Errorf("%w %q: %w", ErrInjectionMarker, name, err)
+	}
+
+	return InjectionBlock{
+		Name:    name,
+		Options: options,
+		Content: string(document[begin.End:end.Start]),
+	}, nil
+}
+
+// InjectBlock returns document with text between markers of named block replaced by content.
+//
+// Markers are kept and content is separated from them by blank lines, so repeated
+// injection of the same content leaves document unchanged.
+func InjectBlock(document []byte, name, content string) ([]byte, error) {
+	text := string(document)
+	begin, end, err := locateInjectionBlock(text, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var out strings.Builder
+	out.Grow(len(text) + len(content) + 4)
+	out.WriteString(text[:begin.End])
+	out.WriteString("\n\n")
+	if content = strings.Trim(normalizeLineEndings(content), "\n"); content != "" {
+		out.WriteString(content)
+		out.WriteString("\n\n")
+	}
+
+	out.WriteString(text[end.Start:])
+	return []byte(out.String()), nil
+}
+
+// locateInjectionBlock returns begin and end markers of named block.
+//
+// Block must be marked exactly once and its end marker must follow begin marker.
+func locateInjectionBlock(text, name string) (injectionMarker, injectionMarker, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || strings.ContainsAny(name, " \t") {
+		return injectionMarker{}, injectionMarker{}, fmt.Errorf("%w: invalid block name %q", ErrInjectionMarker, name)
+	}
+
+	var begin, end *injectionMarker
+	for _, marker := range findInjectionMarkers(text) {
+		if marker.Name != name {
+			continue
+		}
+
+		switch {
+		case marker.Kind == "begin" && begin == nil:
+			begin = &marker
+		case marker.Kind == "begin":
+			return injectionMarker{}, injectionMarker{}, fmt.Errorf("%w %q: duplicate begin marker", ErrInjectionMarker, name)
+		case begin == nil:
+			return injectionMarker{}, injectionMarker{}, fmt.Errorf("%w %q: end marker before begin marker", ErrInjectionMarker, name)
+		case end == nil:
+			end = &marker
+		default:
+			return injectionMarker{}, injectionMarker{}, fmt.Errorf("%w %q: duplicate end marker", ErrInjectionMarker, name)
+		}
+	}
+
+	if begin == nil {
+		return injectionMarker{}, injectionMarker{}, fmt.Errorf("%w %q", ErrInjectionBlockNotFound, name)
+	}
+
+	if end == nil {
+		return injectionMarker{}, injectionMarker{}, fmt.Errorf("%w %q: missing end marker", ErrInjectionMarker, name)
+	}
+
+	return *begin, *end, nil
+}
+
+// findInjectionMarkers returns markers of all blocks in document order, skipping fenced code blocks.
+func findInjectionMarkers(text string) []injectionMarker {
+	var markers []injectionMarker
+
+	inFence := false
+	offset := 0
+	for _, line := range strings.SplitAfter(text, "\n") {
+		lineStart := offset
+		offset += len(line)
+
+		if isMarkdownFence(strings.TrimSpace(line)) {
+			inFence = !inFence
+			continue
+		}
+
+		if inFence {
+			continue
+		}
+
+		for _, match := range injectionMarkerPattern.FindAllStringSubmatchIndex(line, -1) {
+			markerName, rest := line[match[4]:match[5]], ""
+			if split := strings.IndexAny(markerName, " \t"); split >= 0 {
+				markerName, rest = markerName[:split], markerName[split+1:]
+			}
+
+			markers = append(markers, injectionMarker{
+				Kind:  line[match[2]:match[3]],
+				Name:  markerName,
+				Rest:  strings.TrimSpace(rest),
+				Start: lineStart + match[0],
+				End:   lineStart + match[1],
+			})
+		}
+	}
+
+	return markers
+}
+
+// parseInjectionOptions parses space separated `key=value` options of begin marker.
+func parseInjectionOptions(text string) (map[string]string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, nil
+	}
+
+	options := make(map[string]string)
+	for text != "" {
+		match := injectionOptionPattern.FindStringSubmatch(text)
+		if match == nil {
+			option, _, _ := strings.Cut(text, " ")
+			return nil, fmt.Errorf("malformed option %q, expected key=value", option)
+		}
+
+		value := match[2]
+		if strings.HasPrefix(value, `"`) {
+			unquoted, err := strconv.Unquote(value)
+			if err != nil {
+				return nil, fmt.Errorf("malformed option %q: %w", match[0], err)
+			}
+
+			value = unquoted
+		}
+
+		if _, ok := options[match[1]]; ok {
+			return nil, fmt.Errorf("duplicate option %q", match[1])
+		}
+
+		options[match[1]] = value
+		rest := text[len(match[0]):]
+		if rest != "" && rest[0] != ' ' && rest[0] != '\t' {
+			return nil, fmt.Errorf("malformed option %q, expected key=value", match[0]+rest)
+		}
+
+		text = strings.TrimLeft(rest, " \t")
+	}
+
+	return options, nil
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestInjectBlockReplacesContentBetweenMarkers(t *testing.T) {
+	t.Parallel()
+
+	document := "# Project\n\n" +
+		"<!-- schemadoc:begin config template=table heading-offset=1 -->\nstale\n<!-- schemadoc:end config -->\n\n" +
+		"## Footer\n"
+
+	got, err := InjectBlock([]byte(document), "config", "## Config\n\nFresh docs.\n")
+	if err != nil {
+		t.Fatalf("InjectBlock: %v", err)
+	}
+
+	want := "# Project\n\n" +
+		"<!-- schemadoc:begin config template=table heading-offset=1 -->\n\n## Config\n\nFresh docs.\n\n<!-- schemadoc:end config -->\n\n" +
+		"## Footer\n"
+	if string(got) != want {
+		t.Fatalf("unexpected document:\n%s", got)
+	}
+
+	again, err := InjectBlock(got, "config", "## Config\n\nFresh docs.\n")
+	if err != nil {
+		t.Fatalf("InjectBlock again: %v", err)
+	}
+
+	if string(again) != want {
+		t.Fatalf("repeated injection changed document:\n%s", again)
+	}
+}
+
+func TestFindInjectionBlockParsesOptions(t *testing.T) {
+	t.Parallel()
+
+	document := "```markdown\n<!-- schemadoc:begin config template=list -->\n```\n\n" +
+		"<!-- schemadoc:begin other -->\n<!-- schemadoc:end other -->\n" +
+		"<!-- schemadoc:begin config template=table title=\"Config Reference\" heading-offset=2 -->\nold\n<!-- schemadoc:end config -->\n"
+
+	block, err := FindInjectionBlock([]byte(document), "config")
+	if err != nil {
+		t.Fatalf("FindInjectionBlock: %v", err)
+	}
+
+	if block.Name != "config" || block.Content != "\nold\n" {
+		t.Fatalf("unexpected block: %#v", block)
+	}
+
+	want := map[string]string{"template": "table", "title": "Config Reference", "heading-offset": "2"}
+	if len(block.Options) != len(want) {
+		t.Fatalf("unexpected options: %#v", block.Options)
+	}
+
+	for key, value := range want {
+		if block.Options[key] != value {
+			t.Fatalf("option %q = %q, want %q", key, block.Options[key], value)
+		}
+	}
+}
+
+func TestInjectBlockRejectsMalformedMarkers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		want     error
+		name     string
+		document string
+	}{
+		{name: "missing block", document: "<!-- schemadoc:begin other -->\n<!-- schemadoc:end other -->\n", want: ErrInjectionBlockNotFound},
+		{name: "missing end", document: "<!-- schemadoc:begin config -->\n", want: ErrInjectionMarker},
+		{name: "end before begin", document: "<!-- schemadoc:end config -->\n<!-- schemadoc:begin config -->\n", want: ErrInjectionMarker},
+		{name: "duplicate begin", document: strings.Repeat("<!-- schemadoc:begin config -->\n<!-- schemadoc:end config -->\n", 2), want: ErrInjectionMarker},
+	}
+
+	for _, test := range tests {
+		if _, err := InjectBlock([]byte(test.document), "config", "docs"); !errors.Is(err, test.want) {
+			t.Fatalf("%s: expected %v, got %v", test.name, test.want, err)
+		}
+	}
+
+	_, err := FindInjectionBlock([]byte("<!-- schemadoc:begin config template -->\n<!-- schemadoc:end config -->\n"), "config")
+	if !errors.Is(err, ErrInjectionMarker) {
+		t.Fatalf("expected malformed option error, got %v", err)
+	}
+}
+
+func TestRenderShiftsHeadingLevels(t *testing.T) {
+	t.Parallel()
+
+	schemaBytes := minimalSchemaBytes(t, map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"name": map[string]any{
+				"type":        "string",
+				"description": "Name.\n\n```sh\n# comment stays\n```",
+			},
+		},
+	})
+
+	got, err := Render(schemaBytes, Options{Title: "Config", HeadingOffset: 1})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, got, "## Config\n")
+	assertContains(t, got, "\n# comment stays\n")
+	assertNotContains(t, got, "\n# Config\n")
+}
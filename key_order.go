@@ -0,0 +1,299 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+)
+
+const (
+	// PropertyOrderSource keeps property declaration order from source schema.
+	PropertyOrderSource PropertyOrder = "source"
+	// PropertyOrderRequiredFirst lists required properties first, each group in declaration order.
+	PropertyOrderRequiredFirst PropertyOrder = "required-first"
+	// PropertyOrderAlphabetical sorts properties by name.
+	PropertyOrderAlphabetical PropertyOrder = "alphabetical"
+	// PropertyOrderRequiredSorted lists required properties in `required` array order, then other properties by name.
+	//
+	// It is the order used before declaration order was kept.
+	PropertyOrderRequiredSorted PropertyOrder = "required-sorted"
+)
+
+// PropertyOrder configures property order in rendered docs and generated examples.
+type PropertyOrder string
+
+// keyOrder records source declaration order of decoded object keys by map identity.
+//
+// Entries keep map references, so identities stay unique for document lifetime.
+type keyOrder map[uintptr]orderedKeys
+
+// orderedKeys is one recorded object with its keys in declaration order.
+type orderedKeys struct {
+	object map[string]any
+	keys   []string
+}
+
+// record stores declaration order of object keys.
+func (order keyOrder) record(object map[string]any, keys []string) {
+	if order == nil {
+		return
+	}
+
+	order[reflect.ValueOf(object).Pointer()] = orderedKeys{object: object, keys: keys}
+}
+
+// keys returns object keys in declaration order; keys without recorded order follow sorted.
+func (order keyOrder) keys(object map[string]any) []string {
+	entry, ok := order[reflect.ValueOf(object).Pointer()]
+	if !ok {
+		return sortedKeys(object)
+	}
+
+	out := make([]string, 0, len(object))
+	seen := make(map[string]struct{}, len(object))
+	for _, key := range entry.keys {
+		if _, exists := object[key]; !exists {
+			continue
+		}
+
+		if _, exists := seen[key]; exists {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		out = append(out, key)
+	}
+
+	for _, key := range sortedKeys(object) {
+		if _, exists := seen[key]; !exists {
+			out = append(out, key)
+		}
+	}
+
+	return out
+}
+
+// clone deep-copies JSON-like value and records key order of copied objects.
+func (order keyOrder) clone(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(typed))
+		for key, item := range typed {
+			out[key] = order.clone(item)
+		}
+
+		order.record(out, order.keys(typed))
+		return out
+	case []any:
+		out := make([]any, 0, len(typed))
+		for _, item := range typed {
+			out = append(out, order.clone(item))
+		}
+
+		return out
+	default:
+		return typed
+	}
+}
+
+// ordered converts objects of JSON-like value into orderedObject values for encoding in declaration order.
+func (order keyOrder) ordered(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		keys := order.keys(typed)
+		values := make(map[string]any, len(typed))
+		for _, key := range keys {
+			values[key] = order.ordered(typed[key])
+		}
+
+		return orderedObject{values: values, keys: keys}
+	case []any:
+		out := make([]any, 0, len(typed))
+		for _, item := range typed {
+			out = append(out, order.ordered(item))
+		}
+
+		return out
+	default:
+		return typed
+	}
+}
+
+// decodeOrderedJSON decodes first JSON value with json.Number numbers and records object key order.
+func decodeOrderedJSON(schemaBytes []byte, order keyOrder) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(schemaBytes))
+	decoder.UseNumber()
+
+	return decodeOrderedJSONValue(decoder, order)
+}
+
+// decodeOrderedJSONValue reads one JSON value from token stream.
+func decodeOrderedJSONValue(decoder *json.Decoder, order keyOrder) (any, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return token, nil
+	}
+
+	switch delim {
+	case '{':
+		object := make(map[string]any)
+		keys := make([]string, 0)
+		for decoder.More() {
+			keyToken, err := decoder.Token()
+			if err != nil {
+				return nil, nestedJSONError(err)
+			}
+
+			key, ok := keyToken.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid object key %v at offset %d", keyToken, decoder.InputOffset())
+			}
+
+			value, err := decodeOrderedJSONValue(decoder, order)
+			if err != nil {
+				return nil, nestedJSONError(err)
+			}
+
+			if _, exists := object[key]; !exists {
+				keys = append(keys, key)
+			}
+
+			object[key] = value
+		}
+
+		if _, err := decoder.Token(); err != nil {
+			return nil, nestedJSONError(err)
+		}
+
+		order.record(object, keys)
+		return object, nil
+	case '[':
+		items := make([]any, 0)
+		for decoder.More() {
+			value, err := decodeOrderedJSONValue(decoder, order)
+			if err != nil {
+				return nil, nestedJSONError(err)
+			}
+
+			items = append(items, value)
+		}
+
+		if _, err := decoder.Token(); err != nil {
+			return nil, nestedJSONError(err)
+		}
+
+		return items, nil
+	default:
+		return nil, fmt.Errorf("unexpected delimiter %q at offset %d", delim, decoder.InputOffset())
+	}
+}
+
+// nestedJSONError reports end of input inside object or array as unexpected EOF.
+func nestedJSONError(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+// normalizePropertyOrder validates property order mode; empty value selects source order.
+func normalizePropertyOrder(mode PropertyOrder) (PropertyOrder, error) {
+	normalized := PropertyOrder(strings.ToLower(strings.TrimSpace(string(mode))))
+	switch normalized {
+	case "":
+		return PropertyOrderSource, nil
+	case PropertyOrderSource, PropertyOrderRequiredFirst, PropertyOrderAlphabetical, PropertyOrderRequiredSorted:
+		return normalized, nil
+	default:
+		return "", fmt.Errorf("%w %q", ErrUnknownPropertyOrder, mode)
+	}
+}
+
+// propertyOrder returns property names ordered by mode from declaration order and required list.
+func propertyOrder(mode PropertyOrder, declared []string, required []string) []string {
+	if len(declared) == 0 {
+		return nil
+	}
+
+	out := slices.Clone(declared)
+	switch mode {
+	case PropertyOrderAlphabetical:
+		sort.Strings(out)
+	case PropertyOrderRequiredFirst:
+		sort.SliceStable(out, func(i, j int) bool {
+			return isRequired(required, out[i]) && !isRequired(required, out[j])
+		})
+	case PropertyOrderRequiredSorted:
+		out = requiredSortedOrder(declared, required)
+	}
+
+	return out
+}
+
+// requiredSortedOrder returns declared required properties in `required` array order, then other properties sorted.
+func requiredSortedOrder(declared []string, required []string) []string {
+	out := make([]string, 0, len(declared))
+	for _, name := range required {
+		if slices.Contains(declared, name) && !slices.Contains(out, name) {
+			out = append(out, name)
+		}
+	}
+
+	optional := make([]string, 0, len(declared)-len(out))
+	for _, name := range declared {
+		if !slices.Contains(out, name) {
+			optional = append(optional, name)
+		}
+	}
+
+	sort.Strings(optional)
+	return append(out, optional...)
+}
+
+// orderedObject is generated example object that encodes keys in fixed order.
+type orderedObject struct {
+	values map[string]any
+	keys   []string
+}
+
+// MarshalJSON encodes object keys in stored order without HTML escaping.
+func (object orderedObject) MarshalJSON() ([]byte, error) {
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+
+	out.WriteByte('{')
+	for index, key := range object.keys {
+		if index > 0 {
+			out.WriteByte(',')
+		}
+
+		if err := encoder.Encode(key); err != nil {
+			return nil, err
+		}
+
+		out.WriteByte(':')
+		if err := encoder.Encode(object.values[key]); err != nil {
+			return nil, err
+		}
+	}
+
+	out.WriteByte('}')
+	return out.Bytes(), nil
+}
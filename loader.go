@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Loader resolves absolute schema URIs into raw schema documents.
+//
+// It is used for `$ref` values such as `https://example.com/schemas/base.json#/$defs/Base`.
+// The URI passed to Load never contains a fragment.
+type Loader interface {
+	// Load returns raw JSON Schema document bytes for absolute URI.
+	Load(uri string) ([]byte, error)
+}
+
+// MapLoader is an offline Loader that maps URI prefixes to local directories or file systems.
+//
+// The longest matching prefix wins; prefix matches on path segment boundary only,
+// so `https://example.com/schemas` does not match `https://example.com/schemas-v2/base.json`.
+// The URI remainder after the prefix is used as slash-separated path inside mapped directory or file system.
+type MapLoader struct {
+	mappings []loaderMapping
+}
+
+// loaderMapping is one URI prefix to file system mapping; dir is set for local directory mappings.
+type loaderMapping struct {
+	fsys   fs.FS
+	prefix string
+	dir    string
+}
+
+// localPathLoader is implemented by loaders able to map URI to local file path.
+type localPathLoader interface {
+	localPath(uri string) (string, bool)
+}
+
+// NewMapLoader returns empty MapLoader; add mappings with MapDir and MapFS.
+func NewMapLoader() *MapLoader {
+	return &MapLoader{}
+}
+
+// MapDir maps URI prefix to local directory and returns loader for chaining.
+func (loader *MapLoader) MapDir(prefix, dir string) *MapLoader {
+	return loader.addMapping(loaderMapping{prefix: prefix, fsys: os.DirFS(dir), dir: dir})
+}
+
+// MapFS maps URI prefix to file system and returns loader for chaining.
+func (loader *MapLoader) MapFS(prefix string, fsys fs.FS) *MapLoader {
+	return loader.addMapping(loaderMapping{prefix: prefix, fsys: fsys})
+}
+
+// addMapping adds mapping with trimmed prefix and keeps mappings sorted by prefix length, longest first.
+func (loader *MapLoader) addMapping(mapping loaderMapping) *MapLoader {
+	mapping.prefix = strings.TrimSpace(mapping.prefix)
+	loader.mappings = append(loader.mappings, mapping)
+
+	sort.SliceStable(loader.mappings, func(i, j int) bool {
+		return len(loader.mappings[i].prefix) > len(loader.mappings[j].prefix)
+	})
+
+	return loader
+}
+
+// Load reads schema bytes for URI from the longest matching mapped prefix.
+func (loader *MapLoader) Load(uri string) ([]byte, error) {
+	mapping, name, err := loader.resolve(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := fs.ReadFile(mapping.fsys, name)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrLoadSchemaURI, uri, err)
+	}
+
+	return data, nil
+}
+
+// localPath returns absolute local file path of URI mapped to directory with MapDir.
+func (loader *MapLoader) localPath(uri string) (string, bool) {
+	mapping, name, err := loader.resolve(uri)
+	if err != nil || mapping.dir == "" {
+		return "", false
+	}
+
+	path := filepath.Join(mapping.dir, filepath.FromSlash(name))
+	if absolute, err := filepath.Abs(path); err == nil {
+		path = absolute
+	}
+
+	return path, true
+}
+
+// resolve returns the longest matching mapping of URI and slash-separated path inside it.
+func (loader *MapLoader) resolve(uri string) (loaderMapping, string, error) {
+	for _, mapping := range loader.mappings {
+		name, ok := matchURIPrefix(uri, mapping.prefix)
+		if !ok {
+			continue
+		}
+
+		if !fs.ValidPath(name) {
+			return loaderMapping{}, "", fmt.Errorf("%w %q: invalid mapped path %q", ErrLoadSchemaURI, uri, name)
+		}
+
+		return mapping, name, nil
+	}
+
+	return loaderMapping{}, "", fmt.Errorf("%w %q: no mapping for uri prefix", ErrLoadSchemaURI, uri)
+}
+
+// matchURIPrefix returns slash-trimmed URI remainder after mapped prefix and reports whether prefix matches.
+//
+// Prefix matches when it equals URI, ends with slash, or is followed by slash in URI.
+func matchURIPrefix(uri, prefix string) (string, bool) {
+	if prefix == "" || !strings.HasPrefix(uri, prefix) {
+		return "", false
+	}
+
+	rest := uri[len(prefix):]
+	if rest != "" && !strings.HasSuffix(prefix, "/") && !strings.HasPrefix(rest, "/") {
+		return "", false
+	}
+
+	return strings.Trim(rest, "/"), true
+}
@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+func TestMapLoaderLongestPrefixWins(t *testing.T) {
+	t.Parallel()
+
+	loader := NewMapLoader().
+		MapFS("https://example.com/", fstest.MapFS{
+			"schemas/base.json": {Data: []byte(`{"title":"short"}`)},
+		}).
+		MapFS("https://example.com/schemas/", fstest.MapFS{
+			"base.json": {Data: []byte(`{"title":"long"}`)},
+		})
+
+	data, err := loader.Load("https://example.com/schemas/base.json")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if string(data) != `{"title":"long"}` {
+		t.Fatalf("unexpected mapped document: %s", string(data))
+	}
+}
+
+func TestMapLoaderRejectsUnmappedAndEscapingURIs(t *testing.T) {
+	t.Parallel()
+
+	loader := NewMapLoader().MapDir("https://example.com/schemas/", t.TempDir())
+	for _, uri := range []string{
+		"https://other.example.com/base.json",
+		"https://example.com/schemas/../secret.json",
+	} {
+		if _, err := loader.Load(uri); !errors.Is(err, ErrLoadSchemaURI) {
+			t.Fatalf("Load(%q) expected ErrLoadSchemaURI, got: %v", uri, err)
+		}
+	}
+}
+
+func TestMapLoaderMatchesPrefixOnSegmentBoundary(t *testing.T) {
+	t.Parallel()
+
+	loader := NewMapLoader().
+		MapFS("https://example.com/schemas", fstest.MapFS{
+			"base.json": {Data: []byte(`{"title":"v1"}`)},
+		})
+
+	data, err := loader.Load("https://example.com/schemas/base.json")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if string(data) != `{"title":"v1"}` {
+		t.Fatalf("unexpected mapped document: %s", string(data))
+	}
+
+	if _, err := loader.Load("https://example.com/schemas-v2/base.json"); !errors.Is(err, ErrLoadSchemaURI) {
+		t.Fatalf("neighbouring prefix expected ErrLoadSchemaURI, got: %v", err)
+	}
+}
+
+func TestMatchURIPrefixOnSegmentBoundary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		uri    string
+		prefix string
+		name   string
+		ok     bool
+	}{
+		{uri: "https://example.com/schemas/base.json", prefix: "https://example.com/schemas", name: "base.json", ok: true},
+		{uri: "https://example.com/schemas/base.json", prefix: "https://example.com/schemas/", name: "base.json", ok: true},
+		{uri: "https://example.com/schemas", prefix: "https://example.com/schemas", name: "", ok: true},
+		{uri: "https://example.com/schemas-v2/base.json", prefix: "https://example.com/schemas"},
+		{uri: "https://example.com/base.json", prefix: ""},
+	}
+
+	for _, test := range tests {
+		name, ok := matchURIPrefix(test.uri, test.prefix)
+		if name != test.name || ok != test.ok {
+			t.Fatalf("matchURIPrefix(%q, %q) = %q, %v; want %q, %v", test.uri, test.prefix, name, ok, test.name, test.ok)
+		}
+	}
+}
+
+func TestReferencedDocumentsMapsURIsToLocalFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "v1", "base.json"), `{"type": "string"}`)
+	writeTestFile(t, filepath.Join(dir, "v2", "base.json"), `{"type": "integer"}`)
+
+	loader := NewMapLoader().
+		MapDir("https://example.com/schemas", filepath.Join(dir, "v1")).
+		MapDir("https://example.com/schemas-v2/", filepath.Join(dir, "v2")).
+		MapFS("https://example.com/embedded/", fstest.MapFS{
+			"base.json": {Data: []byte(`{"type": "boolean"}`)},
+		})
+
+	schemaBytes := []byte(`{
+  "type": "object",
+  "properties": {
+    "a": { "$ref": "https://example.com/schemas/base.json" },
+    "b": { "$ref": "https://example.com/schemas-v2/base.json" },
+    "c": { "$ref": "https://example.com/embedded/base.json" }
+  }
+}`)
+
+	documents, err := ReferencedDocuments(schemaBytes, Options{Loader: loader})
+	if err != nil {
+		t.Fatalf("ReferencedDocuments: %v", err)
+	}
+
+	want := []string{
+		filepath.Join(dir, "v1", "base.json"),
+		filepath.Join(dir, "v2", "base.json"),
+		"https://example.com/embedded/base.json",
+	}
+	slices.Sort(want)
+	if !slices.Equal(documents, want) {
+		t.Fatalf("unexpected documents: %q, want %q", documents, want)
+	}
+}
+
+func TestRenderResolvesURIReferencesWithLoader(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "base.json"), `{
+  "$id": "https://example.com/schemas/base.json",
+  "$defs": {
+    "Base": {
+      "type": "object",
+      "properties": {
+        "owner": { "$ref": "common.json#/$defs/Owner" }
+      }
+    }
+  }
+}`)
+	writeTestFile(t, filepath.Join(dir, "common.json"), `{
+  "$defs": {
+    "Owner": { "type": "string", "default": "platform" }
+  }
+}`)
+
+	schemaBytes := minimalSchemaBytes(t, map[string]any{
+		"$ref": "#/$defs/Config",
+		"$defs": map[string]any{
+			"Config": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"base": map[string]any{"$ref": "https://example.com/schemas/base.json#/$defs/Base"},
+				},
+			},
+		},
+	})
+
+	opt := Options{Loader: NewMapLoader().MapDir("https://example.com/schemas/", dir)}
+	rendered, err := Render(schemaBytes, opt)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "## Base")
+	assertContains(t, rendered, "## Owner")
+	assertContains(t, rendered, "Path: `base.owner`")
+
+	opt.ExampleFormat = ExampleFormatJSON
+	data, err := GenerateExampleWithOptions(schemaBytes, opt)
+	if err != nil {
+		t.Fatalf("GenerateExampleWithOptions: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal generated json: %v", err)
+	}
+
+	base, _ := got["base"].(map[string]any)
+	if base["owner"] != "platform" {
+		t.Fatalf("uri reference was not resolved in example:\n%s", string(data))
+	}
+}
+
+func TestRenderKeepsURIReferencesWithoutLoader(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render(minimalSchemaBytes(t, map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"base": map[string]any{"$ref": "https://example.com/schemas/base.json"},
+		},
+	}), Options{})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "Reference: `https://example.com/schemas/base.json`")
+}
+
+func TestRenderReturnsErrorForUnmappedURIReference(t *testing.T) {
+	t.Parallel()
+
+	_, err := Render(minimalSchemaBytes(t, map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"base": map[string]any{"$ref": "https://example.com/schemas/base.json"},
+		},
+	}), Options{Loader: NewMapLoader()})
+	if !errors.Is(err, ErrResolveSchemaReference) || !errors.Is(err, ErrLoadSchemaURI) {
+		t.Fatalf("expected unmapped uri error, got: %v", err)
+	}
+}
@@ -5,8 +5,6 @@
 package schemadoc
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -32,7 +30,38 @@ type Options struct {
 	//
 	//  - `list`
 	//  - `table`
-	TemplateName string `json:"template_name,omitempty" jsonschema:"default=list,enum=list,enum=table,example=list,example=table"`
+	//  - `html`
+	//  - `asciidoc`
+	//  - `rst`
+	//  - `man`
+	TemplateName string `json:"template_name,omitempty" jsonschema:"default=list,enum=list,enum=table,enum=html,enum=asciidoc,enum=rst,enum=man,example=list,example=table"`
+
+	// Format selects output backend.
+	//
+	// Supported values:
+	//  - `markdown`
+	//  - `html`
+	//  - `asciidoc`
+	//  - `rst`
+	//  - `man`
+	//
+	// Empty value follows TemplateName: `html`, `asciidoc`, `rst` and `man` templates render
+	// their own format, others render markdown.
+	// HTML output is rendered with html/template, so custom TemplateText is parsed the same way.
+	Format OutputFormat `json:"format,omitempty" jsonschema:"default=markdown,enum=markdown,enum=html,enum=asciidoc,enum=rst,enum=man,example=markdown,example=html"`
+
+	// ManSection is man page section written to `.TH` header of `man` output.
+	//
+	// Empty value uses section `5` (file formats).
+	ManSection string `json:"man_section,omitempty" jsonschema:"default=5,example=5,example=7"`
+
+	// ManDate is man page date written to `.TH` header of `man` output.
+	//
+	// Empty value leaves date blank, keeping output reproducible.
+	ManDate string `json:"man_date,omitempty" jsonschema:"example=2026-01-31,example=January 2026"`
+
+	// ManSource is man page source written to `.TH` header of `man` output, usually project name and version.
+	ManSource string `json:"man_source,omitempty" jsonschema:"example=myapp 1.4.0"`
 
 	// TemplateText overrides built-in templates with custom template text.
 	//
@@ -66,6 +95,86 @@ type Options struct {
 	//
 	// Markdown structures such as lists, blockquotes, and fenced code blocks are preserved.
 	WrapWidth int `json:"wrap_width,omitempty" jsonschema:"default=80,minimum=1,example=80,example=100"`
+
+	// NestedDepth limits how many levels of inline nested object properties are documented.
+	//
+	// Inline objects (and arrays of inline objects) without `$ref` are expanded
+	// into sub-property sections with full dotted paths.
+	// Zero uses default depth 5; negative value disables nested expansion.
+	NestedDepth int `json:"nested_depth,omitempty" jsonschema:"default=5,minimum=-1,example=5,example=-1"`
+
+	// DiagramDepth limits reference hops from root definition shown in definition diagrams.
+	//
+	// It applies to DOT output and Mermaid diagrams of `mermaid` template function.
+	// Zero or negative value shows all definitions reachable from root definition.
+	DiagramDepth int `json:"diagram_depth,omitempty" jsonschema:"default=0,example=0,example=2"`
+
+	// HeadingOffset shifts every markdown heading down by this many levels, for example `#` to `##`.
+	//
+	// Use it to embed rendered docs under existing headings. Levels are capped at 6
+	// and heading anchors do not change. Zero or negative value keeps levels.
+	HeadingOffset int `json:"heading_offset,omitempty" jsonschema:"default=0,minimum=0,maximum=5,example=0,example=1"`
+
+	// PropertyOrder controls property order in rendered docs and generated example keys.
+	//
+	// Supported values:
+	//  - `source`
+	//  - `required-first`
+	//  - `alphabetical`
+	//  - `required-sorted` (required array order, then others by name; former default)
+	PropertyOrder PropertyOrder `json:"property_order,omitempty" jsonschema:"default=source,enum=source,enum=required-first,enum=alphabetical,enum=required-sorted,example=source,example=required-first"`
+
+	// RootDefinition selects definition rendered first and used as origin of property paths.
+	//
+	// Empty value uses root `$ref` target, or root schema itself when it declares properties.
+	// Otherwise definitions are rendered by name without property paths, as paths have no origin.
+	RootDefinition string `json:"root_definition,omitempty" jsonschema:"example=Config,example=Server"`
+
+	// IncludeDefinitions lists glob patterns of definition names to document, for example `*Config`.
+	//
+	// Patterns use path.Match syntax. Empty list documents all definitions.
+	// Root definition is always documented.
+	IncludeDefinitions []string `json:"include_definitions,omitempty" jsonschema:"example=*Config,example=Server"`
+
+	// ExcludeDefinitions lists glob patterns of definition names left out of docs, for example `internal*`.
+	//
+	// Exclusion wins over IncludeDefinitions. References to left out definitions render as plain code.
+	ExcludeDefinitions []string `json:"exclude_definitions,omitempty" jsonschema:"example=internal*,example=*State"`
+
+	// PageName is file name pattern of definition pages in multi-page output (RenderPages).
+	//
+	// `{slug}` is replaced with heading anchor of definition name and `{name}` with
+	// definition name restricted to file name characters; colliding names get `-2`, `-3` suffixes.
+	// Empty value uses `{slug}.md`.
+	PageName string `json:"page_name,omitempty" jsonschema:"default={slug}.md,example={slug}.md,example={name}.md"`
+
+	// IndexPage is file name of index page in multi-page output (RenderPages).
+	//
+	// Empty value uses `index.md`.
+	IndexPage string `json:"index_page,omitempty" jsonschema:"default=index.md,example=index.md,example=README.md"`
+
+	// BaseDir is the directory used to resolve relative file references in `$ref` values.
+	//
+	// RenderFile and GenerateExampleFile default it to the schema file directory.
+	// Empty value resolves references from the current working directory.
+	BaseDir string `json:"base_dir,omitempty" jsonschema:"example=internal/config,example=schemas"`
+
+	// Loader resolves absolute `$ref` URIs such as `https://example.com/schemas/base.json`.
+	//
+	// Use MapLoader for offline URI prefix to local directory mapping.
+	// Nil loader leaves absolute URI references unresolved.
+	Loader Loader `json:"-"`
+
+	// DropOrphans leaves out definitions not referenced directly or transitively from root definition.
+	//
+	// Without root definition no definition is left out.
+	DropOrphans bool `json:"drop_orphans,omitempty" jsonschema:"default=false"`
+
+	// SettingsIndex adds "All settings" table of every leaf property path to markdown templates as `.Index`.
+	//
+	// Rows hold type, required flag, default and first description paragraph
+	// and link to detailed property sections.
+	SettingsIndex bool `json:"settings_index,omitempty" jsonschema:"default=false"`
 }
 
 // DraftInfo describes detected JSON Schema draft support status.
@@ -89,6 +198,9 @@ type SchemaModel struct {
 
 	// DraftInfo is the normalized output of draft detection.
 	DraftInfo DraftInfo `json:"draft_info" jsonschema:"required"`
+
+	// Project is project file model run by `schemadoc generate`.
+	Project Project `json:"project,omitempty"`
 }
 
 // schemaDocument is the normalized JSON Schema root model used by renderer.
@@ -99,6 +211,7 @@ type schemaDocument struct {
 	Schema      string
 	ID          string
 	Ref         string
+	KeyOrder    keyOrder
 	Draft       DraftInfo
 }
 
@@ -113,16 +226,57 @@ func (value schemaValue) isZero() bool {
 	return value.Bool == nil && value.Object == nil
 }
 
-// parseDocument decodes raw schema bytes into normalized schemaDocument model.
-func parseDocument(schemaBytes []byte) (schemaDocument, error) {
-	decoder := json.NewDecoder(bytes.NewReader(schemaBytes))
-	decoder.UseNumber()
+// loadDocument decodes raw schema bytes, canonicalizes `$id` and anchor references
+// and bundles external references into local definitions.
+func loadDocument(schemaBytes []byte, opt Options) (schemaDocument, error) {
+	order := make(keyOrder)
+	root, err := decodeSchema(schemaBytes, order)
+	if err != nil {
+		return schemaDocument{}, err
+	}
 
-	var root any
-	if err := decoder.Decode(&root); err != nil {
-		return schemaDocument{}, fmt.Errorf("%w: %w", ErrDecodeSchema, err)
+	canonicalizeReferences(root, "")
+	if err := bundleReferences(root, opt, order); err != nil {
+		return schemaDocument{}, err
 	}
 
+	return newOrderedSchemaDocument(root, order)
+}
+
+// decodeSchema decodes raw JSON or YAML schema bytes into JSON-like value tree with json.Number numbers.
+//
+// Object key declaration order is recorded into order when it is not nil.
+// Input that is not valid JSON is retried as flow-style YAML; JSON error is kept when both fail.
+func decodeSchema(schemaBytes []byte, order keyOrder) (any, error) {
+	if isYAMLSchema(schemaBytes) {
+		return decodeYAMLSchema(schemaBytes, order)
+	}
+
+	root, err := decodeOrderedJSON(schemaBytes, order)
+	if err != nil {
+		if root, yamlErr := decodeYAMLSchema(schemaBytes, order); yamlErr == nil {
+			return root, nil
+		}
+
+		return nil, fmt.Errorf("%w: %w", ErrDecodeSchema, err)
+	}
+
+	return root, nil
+}
+
+// newOrderedSchemaDocument normalizes decoded schema value tree and attaches key declaration order.
+func newOrderedSchemaDocument(root any, order keyOrder) (schemaDocument, error) {
+	doc, err := newSchemaDocument(root)
+	if err != nil {
+		return schemaDocument{}, err
+	}
+
+	doc.KeyOrder = order
+	return doc, nil
+}
+
+// newSchemaDocument normalizes decoded schema value tree into schemaDocument model.
+func newSchemaDocument(root any) (schemaDocument, error) {
 	rootValue, ok := toSchemaValue(root)
 	if !ok {
 		return schemaDocument{}, fmt.Errorf("%w: got %T", ErrSchemaRootType, root)
@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// projectDefinitionRef points to project file model in project schema.
+const projectDefinitionRef = "#/$defs/Project"
+
+// embeddedProjectSchema is JSON Schema reflected from Project type, regenerated by `make example`.
+//
+//go:embed project.schema.json
+var embeddedProjectSchema []byte
+
+// projectDocumentFormats lists output formats of project outputs that render documentation.
+var projectDocumentFormats = map[string]bool{
+	"markdown": true,
+	"html":     true,
+	"asciidoc": true,
+	"rst":      true,
+	"man":      true,
+}
+
+// Project is project file model read by `schemadoc generate`, usually `schemadoc.yaml` or `schemadoc.json`.
+//
+// Relative paths in jobs are resolved from project file directory.
+type Project struct {
+	// Schema is optional URI of JSON Schema describing project file, used by editors.
+	Schema string `json:"$schema,omitempty" jsonschema:"example=https://github.com/woozymasta/schemadoc/project"`
+
+	// Jobs lists generation jobs run in declaration order.
+	Jobs []ProjectJob `json:"jobs" jsonschema:"required,minItems=1"`
+}
+
+// ProjectJob is one generation job: schema source, shared options and output files.
+type ProjectJob struct {
+	// Module reflects Go type into schema, as `mod2schema` does, instead of reading schema file.
+	//
+	// Exactly one of Schema and Module must be set.
+	Module *ProjectModule `json:"module,omitempty"`
+
+	// Name identifies job in errors and `--job` selection.
+	Name string `json:"name,omitempty" jsonschema:"minLength=1,example=config,example=server"`
+
+	// Schema is input JSON or YAML schema file path.
+	Schema string `json:"schema,omitempty" jsonschema:"minLength=1,example=schemas/config.json"`
+
+	// TemplateFile is path to custom markdown template file used instead of built-in template.
+	//
+	// Like Options.TemplateName, it applies to markdown outputs without Template only.
+	TemplateFile string `json:"template_file,omitempty" jsonschema:"minLength=1,example=templates/config.gotmpl"`
+
+	// MapURI maps absolute `$ref` URI prefixes to local directories as `PREFIX=DIR`.
+	MapURI []string `json:"map_uri,omitempty" jsonschema:"example=https://example.com/schemas/=schemas"`
+
+	// Outputs lists files generated from job schema.
+	Outputs []ProjectOutput `json:"outputs" jsonschema:"required,minItems=1"`
+
+	// Options configures rendering and example generation shared by all job outputs.
+	//
+	// TemplateName and TemplateText apply to markdown outputs only; other documentation
+	// formats use their own built-in template unless output sets Template.
+	Options Options `json:"options,omitempty"`
+}
+
+// ProjectModule selects Go type reflected into job schema.
+type ProjectModule struct {
+	// Path is Go module import path.
+	Path string `json:"path" jsonschema:"required,minLength=1,example=github.com/acme/project"`
+
+	// Type is reflected root type name.
+	Type string `json:"type" jsonschema:"required,minLength=1,example=Config"`
+
+	// Package is import path of package declaring Type; empty value uses Path.
+	Package string `json:"package,omitempty" jsonschema:"example=github.com/acme/project/internal/config"`
+
+	// Root is local module directory; empty value uses project file directory.
+	Root string `json:"root,omitempty" jsonschema:"example=."`
+}
+
+// ProjectOutput is one file, or page directory, written by job.
+type ProjectOutput struct {
+	// Path is output file path, or output directory when Pages is set.
+	Path string `json:"path" jsonschema:"required,minLength=1,example=docs/config.md,example=config.example.yaml"`
+
+	// Format selects generated output.
+	//
+	// Supported values:
+	//  - `markdown`, `html`, `asciidoc`, `rst`, `man` render documentation
+	//  - `json`, `yaml` generate example payload
+	//  - `view` exports documentation view model JSON
+	//  - `dot` renders definition graph
+	Format string `json:"format" jsonschema:"required,enum=markdown,enum=html,enum=asciidoc,enum=rst,enum=man,enum=json,enum=yaml,enum=view,enum=dot,example=markdown,example=yaml"`
+
+	// Template overrides Options.TemplateName of job for this output.
+	Template string `json:"template,omitempty" jsonschema:"enum=list,enum=table,enum=html,enum=asciidoc,enum=rst,enum=man,example=table"`
+
+	// Inject names marker block of existing Path file replaced with output, as `--inject` does.
+	//
+	// Example payloads are injected as fenced code blocks.
+	Inject string `json:"inject,omitempty" jsonschema:"minLength=1,example=config"`
+
+	// Pages writes index page and one page per definition into Path directory (markdown only).
+	Pages bool `json:"pages,omitempty" jsonschema:"default=false"`
+}
+
+// LoadProjectFile reads project file and validates it with LoadProject.
+func LoadProjectFile(path string) (Project, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Project{}, fmt.Errorf("%w: %w", ErrReadProjectFile, err)
+	}
+
+	return LoadProject(data)
+}
+
+// LoadProject decodes JSON or YAML project file and validates it against project schema.
+//
+// All schema violations are reported at once with JSON pointers of offending values.
+// Job rules not expressed in schema, such as exactly one schema source, are checked after it.
+func LoadProject(data []byte) (Project, error) {
+	instance, err := decodeSchema(data, nil)
+	if err != nil {
+		return Project{}, fmt.Errorf("%w: %w", ErrInvalidProject, err)
+	}
+
+	schemaRoot, err := decodeSchema(embeddedProjectSchema, nil)
+	if err != nil {
+		return Project{}, fmt.Errorf("%w: project schema: %w", ErrInvalidProject, err)
+	}
+
+	projectSchema, ok := resolveJSONPointer(schemaRoot, projectDefinitionRef)
+	if !ok {
+		return Project{}, fmt.Errorf("%w: project schema has no %s", ErrInvalidProject, projectDefinitionRef)
+	}
+
+	if violations := validateInstance(schemaRoot, projectSchema, instance); len(violations) > 0 {
+		return Project{}, fmt.Errorf("%w:\n  %s", ErrInvalidProject, strings.Join(violations, "\n  "))
+	}
+
+	encoded, err := json.Marshal(instance)
+	if err != nil {
+		return Project{}, fmt.Errorf("%w: %w", ErrInvalidProject, err)
+	}
+
+	var project Project
+	if err := json.Unmarshal(encoded, &project); err != nil {
+		return Project{}, fmt.Errorf("%w: %w", ErrInvalidProject, err)
+	}
+
+	if err := checkProjectJobs(project.Jobs); err != nil {
+		return Project{}, fmt.Errorf("%w: %w", ErrInvalidProject, err)
+	}
+
+	return project, nil
+}
+
+// checkProjectJobs checks schema source, unique names and output combinations of jobs.
+func checkProjectJobs(jobs []ProjectJob) error {
+	names := make(map[string]bool, len(jobs))
+	for index, job := range jobs {
+		label := fmt.Sprintf("/jobs/%d", index)
+		if job.Name != "" {
+			if names[job.Name] {
+				return fmt.Errorf("%s: duplicate job name %q", label, job.Name)
+			}
+
+			names[job.Name] = true
+		}
+
+		if (job.Schema == "") == (job.Module == nil) {
+			return fmt.Errorf("%s: exactly one of schema and module must be set", label)
+		}
+
+		for outputIndex, output := range job.Outputs {
+			outputLabel := fmt.Sprintf("%s/outputs/%d", label, outputIndex)
+			switch {
+			case output.Pages && output.Format != "markdown":
+				return fmt.Errorf("%s: pages require markdown format, got %q", outputLabel, output.Format)
+			case output.Pages && output.Inject != "":
+				return fmt.Errorf("%s: pages and inject are mutually exclusive", outputLabel)
+			case output.Template != "" && !projectDocumentFormats[output.Format]:
+				return fmt.Errorf("%s: template is not used by %q format", outputLabel, output.Format)
+			case output.Inject != "" && output.Format != "markdown" && output.Format != "json" && output.Format != "yaml":
+				return fmt.Errorf("%s: inject supports markdown, json and yaml formats, got %q", outputLabel, output.Format)
+			}
+		}
+	}
+
+	return nil
+}
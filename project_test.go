@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestLoadProjectDecodesYAMLJobs(t *testing.T) {
+	t.Parallel()
+
+	project, err := LoadProject([]byte(`
+jobs:
+  - name: config
+    schema: schemas/config.json
+    options:
+      title: Config Reference
+      template_name: table
+      heading_offset: 1
+    outputs:
+      - path: docs/config.md
+        format: markdown
+      - path: README.md
+        format: yaml
+        inject: example
+  - name: model
+    module:
+      path: github.com/acme/project
+      type: Config
+    outputs:
+      - path: docs/model
+        format: markdown
+        pages: true
+`))
+	if err != nil {
+		t.Fatalf("LoadProject: %v", err)
+	}
+
+	if len(project.Jobs) != 2 {
+		t.Fatalf("unexpected jobs: %#v", project.Jobs)
+	}
+
+	config := project.Jobs[0]
+	if config.Schema != "schemas/config.json" || config.Options.Title != "Config Reference" ||
+		config.Options.TemplateName != "table" || config.Options.HeadingOffset != 1 {
+		t.Fatalf("unexpected config job: %#v", config)
+	}
+
+	if len(config.Outputs) != 2 || config.Outputs[1].Inject != "example" {
+		t.Fatalf("unexpected config outputs: %#v", config.Outputs)
+	}
+
+	model := project.Jobs[1]
+	if model.Module == nil || model.Module.Type != "Config" || !model.Outputs[0].Pages {
+		t.Fatalf("unexpected model job: %#v", model)
+	}
+}
+
+func TestLoadProjectReportsSchemaViolations(t *testing.T) {
+	t.Parallel()
+
+	_, err := LoadProject([]byte(`{
+  "jobs": [
+    {
+      "schema": "schema.json",
+      "options": {"template_name": "fancy", "wrap_width": "wide"},
+      "outputs": [{"path": "out.md", "format": "md", "extra": true}]
+    }
+  ]
+}`))
+	if !errors.Is(err, ErrInvalidProject) {
+		t.Fatalf("expected ErrInvalidProject, got %v", err)
+	}
+
+	assertContains(t, err.Error(), `/jobs/0/options/template_name: value "fancy" is not one of "list", "table"`)
+	assertContains(t, err.Error(), "/jobs/0/options/wrap_width: expected integer, got string")
+	assertContains(t, err.Error(), `/jobs/0/outputs/0/format: value "md" is not one of "markdown"`)
+	assertContains(t, err.Error(), `/jobs/0/outputs/0: property "extra" is not allowed`)
+
+	_, err = LoadProject([]byte("jobs: []\n"))
+	assertContains(t, err.Error(), "/jobs: array has fewer than 1 items")
+}
+
+func TestLoadProjectChecksJobRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		project string
+		want    string
+	}{
+		{
+			name:    "no source",
+			project: "jobs:\n  - outputs:\n      - {path: out.md, format: markdown}\n",
+			want:    "/jobs/0: exactly one of schema and module must be set",
+		},
+		{
+			name: "duplicate name",
+			project: "jobs:\n  - {name: a, schema: a.json, outputs: [{path: a.md, format: markdown}]}\n" +
+				"  - {name: a, schema: b.json, outputs: [{path: b.md, format: markdown}]}\n",
+			want: `/jobs/1: duplicate job name "a"`,
+		},
+		{
+			name:    "pages format",
+			project: "jobs:\n  - {schema: a.json, outputs: [{path: docs, format: html, pages: true}]}\n",
+			want:    `/jobs/0/outputs/0: pages require markdown format, got "html"`,
+		},
+		{
+			name:    "inject format",
+			project: "jobs:\n  - {schema: a.json, outputs: [{path: a.dot, format: dot, inject: graph}]}\n",
+			want:    `/jobs/0/outputs/0: inject supports markdown, json and yaml formats, got "dot"`,
+		},
+	}
+
+	for _, test := range tests {
+		_, err := LoadProject([]byte(test.project))
+		if !errors.Is(err, ErrInvalidProject) {
+			t.Fatalf("%s: expected ErrInvalidProject, got %v", test.name, err)
+		}
+
+		assertContains(t, err.Error(), test.want)
+	}
+}
+
+func TestValidateInstanceCompositionsAndRanges(t *testing.T) {
+	t.Parallel()
+
+	root := map[string]any{}
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"port": map[string]any{"type": "integer", "minimum": json.Number("1"), "maximum": json.Number("65535")},
+			"mode": map[string]any{"oneOf": []any{
+				map[string]any{"const": "fast"},
+				map[string]any{"type": "string", "pattern": "^slow"},
+			}},
+		},
+	}
+
+	instance := map[string]any{"port": json.Number("70000"), "mode": "medium"}
+	violations := validateInstance(root, schema, instance)
+	if len(violations) != 2 {
+		t.Fatalf("unexpected violations: %q", violations)
+	}
+
+	if violations[0] != "/mode: value matches 0 oneOf alternatives, expected exactly one" ||
+		violations[1] != "/port: value 70000 is greater than maximum 65535" {
+		t.Fatalf("unexpected violations: %q", violations)
+	}
+
+	if violations := validateInstance(root, schema, map[string]any{"port": json.Number("8080"), "mode": "slowly"}); len(violations) != 0 {
+		t.Fatalf("unexpected violations of valid instance: %q", violations)
+	}
+}
@@ -0,0 +1,233 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// referenceKeywords lists keywords whose values are schema references, in resolution priority order.
+var referenceKeywords = []string{"$ref", "$dynamicRef", "$recursiveRef"}
+
+// subschemaKeywords lists keywords holding one subschema (or a subschema list for `items`).
+var subschemaKeywords = []string{
+	"additionalItems",
+	"additionalProperties",
+	"contains",
+	"contentSchema",
+	"else",
+	"if",
+	"items",
+	"not",
+	"propertyNames",
+	"then",
+	"unevaluatedItems",
+	"unevaluatedProperties",
+}
+
+// subschemaListKeywords lists keywords holding arrays of subschemas.
+var subschemaListKeywords = []string{"allOf", "anyOf", "oneOf", "prefixItems"}
+
+// subschemaMapKeywords lists keywords holding maps of named subschemas.
+var subschemaMapKeywords = []string{
+	"$defs",
+	"definitions",
+	"dependencies",
+	"dependentSchemas",
+	"patternProperties",
+	"properties",
+}
+
+// schemaIndex maps embedded `$id` resources and anchors to local JSON pointers.
+type schemaIndex struct {
+	resources map[string]string
+	anchors   map[string]string
+}
+
+// buildSchemaIndex indexes `$id`, `$anchor` and `$dynamicAnchor` of every subschema in document.
+//
+// Pointers are stored without leading `#`; root document pointer is empty string.
+func buildSchemaIndex(root map[string]any, base string) schemaIndex {
+	index := schemaIndex{
+		resources: make(map[string]string),
+		anchors:   make(map[string]string),
+	}
+
+	index.add(root, "", base)
+	return index
+}
+
+// add registers identifiers declared by schema object and recurses into its subschemas.
+func (index schemaIndex) add(object map[string]any, pointer, base string) {
+	base = schemaBaseURI(object, base)
+	if _, exists := index.resources[base]; !exists {
+		index.resources[base] = pointer
+	}
+
+	for _, keyword := range []string{"$anchor", "$dynamicAnchor"} {
+		anchor := asString(object[keyword])
+		if anchor == "" {
+			continue
+		}
+
+		key := base + "#" + anchor
+		if _, exists := index.anchors[key]; !exists {
+			index.anchors[key] = pointer
+		}
+	}
+
+	forEachSubschema(object, pointer, func(childPointer string, child map[string]any) {
+		index.add(child, childPointer, base)
+	})
+}
+
+// resolve returns local JSON pointer for reference evaluated against base URI.
+func (index schemaIndex) resolve(ref, base string) (string, bool) {
+	resolved := resolveURIReference(base, ref)
+	uri, fragment, _ := strings.Cut(resolved, "#")
+
+	resource, ok := index.resources[uri]
+	if !ok {
+		return "", false
+	}
+
+	switch {
+	case fragment == "":
+		return resource, true
+	case strings.HasPrefix(fragment, "/"):
+		return resource + fragment, true
+	default:
+		pointer, ok := index.anchors[uri+"#"+fragment]
+		return pointer, ok
+	}
+}
+
+// canonicalizeReferences rewrites `$ref`, `$dynamicRef` and `$recursiveRef` values that target
+// embedded `$id` resources or anchors into local JSON pointers.
+//
+// References that cannot be resolved inside the document are left unchanged.
+func canonicalizeReferences(root any, base string) {
+	rootObject, ok := root.(map[string]any)
+	if !ok {
+		return
+	}
+
+	index := buildSchemaIndex(rootObject, base)
+	index.canonicalize(rootObject, "", schemaBaseURI(rootObject, base), schemaBaseURI(rootObject, base))
+}
+
+// canonicalize rewrites references of one schema object and recurses into subschemas.
+func (index schemaIndex) canonicalize(object map[string]any, pointer, rootBase, base string) {
+	base = schemaBaseURI(object, base)
+
+	for _, keyword := range referenceKeywords {
+		ref := asString(object[keyword])
+		if ref == "" {
+			continue
+		}
+
+		if base == rootBase && (ref == "#" || strings.HasPrefix(ref, "#/")) {
+			continue
+		}
+
+		if target, ok := index.resolve(ref, base); ok {
+			object[keyword] = "#" + target
+		}
+	}
+
+	forEachSubschema(object, pointer, func(childPointer string, child map[string]any) {
+		index.canonicalize(child, childPointer, rootBase, base)
+	})
+}
+
+// forEachSubschema calls visit for every object subschema in deterministic keyword order.
+func forEachSubschema(object map[string]any, pointer string, visit func(childPointer string, child map[string]any)) {
+	for _, keyword := range subschemaKeywords {
+		switch typed := object[keyword].(type) {
+		case map[string]any:
+			visit(pointer+"/"+keyword, typed)
+		case []any:
+			visitSubschemaList(typed, pointer+"/"+keyword, visit)
+		}
+	}
+
+	for _, keyword := range subschemaListKeywords {
+		visitSubschemaList(asSlice(object[keyword]), pointer+"/"+keyword, visit)
+	}
+
+	for _, keyword := range subschemaMapKeywords {
+		values, ok := object[keyword].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for _, key := range sortedKeys(values) {
+			child, ok := values[key].(map[string]any)
+			if !ok {
+				continue
+			}
+
+			visit(pointer+"/"+keyword+"/"+encodeJSONPointerToken(key), child)
+		}
+	}
+}
+
+// visitSubschemaList calls visit for object entries of subschema array.
+func visitSubschemaList(items []any, pointer string, visit func(childPointer string, child map[string]any)) {
+	for index, item := range items {
+		child, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		visit(pointer+"/"+strconv.Itoa(index), child)
+	}
+}
+
+// schemaBaseURI returns base URI of schema object resolved from its `$id` against parent base.
+func schemaBaseURI(object map[string]any, base string) string {
+	id := firstNonEmpty(asString(object["$id"]), asString(object["id"]))
+	if id == "" || strings.HasPrefix(id, "#") {
+		return base
+	}
+
+	resolved, _, _ := strings.Cut(resolveURIReference(base, id), "#")
+	return resolved
+}
+
+// resolveURIReference resolves reference against base URI using RFC 3986 rules.
+//
+// Invalid values fall back to unresolved reference text.
+func resolveURIReference(base, ref string) string {
+	ref = strings.TrimSpace(ref)
+	if base == "" {
+		return ref
+	}
+
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return ref
+	}
+
+	refURL, err := url.Parse(ref)
+	if err != nil {
+		return ref
+	}
+
+	return baseURL.ResolveReference(refURL).String()
+}
+
+// schemaReference returns first reference value of schema object (`$ref`, `$dynamicRef`, `$recursiveRef`).
+func schemaReference(object map[string]any) string {
+	for _, keyword := range referenceKeywords {
+		if ref := asString(object[keyword]); ref != "" {
+			return ref
+		}
+	}
+
+	return ""
+}
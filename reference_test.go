@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+)
+
+func TestRenderResolvesAnchorAndIDReferences(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render(minimalSchemaBytes(t, map[string]any{
+		"$id":  "https://example.com/schemas/config.json",
+		"$ref": "#/$defs/Config",
+		"$defs": map[string]any{
+			"Config": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"listen": map[string]any{"$ref": "#listener"},
+					"store":  map[string]any{"$ref": "store.json"},
+				},
+			},
+			"Listener": map[string]any{
+				"$anchor": "listener",
+				"type":    "object",
+				"properties": map[string]any{
+					"port": map[string]any{"type": "integer"},
+				},
+			},
+			"Store": map[string]any{
+				"$id":  "store.json",
+				"type": "object",
+				"properties": map[string]any{
+					"path":   map[string]any{"type": "string"},
+					"backup": map[string]any{"$ref": "#/properties/path"},
+				},
+			},
+		},
+	}), Options{})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "Reference: [`#/$defs/Listener`](#listener)")
+	assertContains(t, rendered, "Reference: [`#/$defs/Store`](#store)")
+	assertContains(t, rendered, "Reference: `#/$defs/Store/properties/path`")
+	assertContains(t, rendered, "Path: `listen.port`")
+	assertContains(t, rendered, "Path: `store.path`")
+}
+
+func TestGenerateExampleFollowsDynamicReferences(t *testing.T) {
+	t.Parallel()
+
+	data, err := GenerateExampleJSON(minimalSchemaBytes(t, map[string]any{
+		"$ref": "#/$defs/Tree",
+		"$defs": map[string]any{
+			"Tree": map[string]any{
+				"$dynamicAnchor": "node",
+				"type":           "object",
+				"properties": map[string]any{
+					"name": map[string]any{"type": "string", "default": "root"},
+					"children": map[string]any{
+						"type":  "array",
+						"items": map[string]any{"$dynamicRef": "#node"},
+					},
+				},
+			},
+		},
+	}), ExampleModeAll)
+	if err != nil {
+		t.Fatalf("GenerateExampleJSON: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal generated json: %v", err)
+	}
+
+	if got["name"] != "root" {
+		t.Fatalf("unexpected example root:\n%s", string(data))
+	}
+
+	if _, ok := got["children"].([]any); !ok {
+		t.Fatalf("dynamic reference was not resolved in example:\n%s", string(data))
+	}
+}
+
+func TestBundleResolvesExternalAnchorReferences(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "common.json"), `{
+  "$defs": {
+    "TLS": {
+      "$anchor": "tls",
+      "type": "object",
+      "properties": { "ca": { "$ref": "#ca" } }
+    },
+    "CA": { "$anchor": "ca", "type": "string" }
+  }
+}`)
+
+	data, err := Bundle([]byte(`{
+  "type": "object",
+  "properties": { "tls": { "$ref": "common.json#tls" } }
+}`), Options{BaseDir: dir})
+	if err != nil {
+		t.Fatalf("Bundle: %v", err)
+	}
+
+	assertContains(t, string(data), `"$ref": "#/$defs/TLS"`)
+	assertContains(t, string(data), `"$ref": "#/$defs/CA"`)
+	assertNotContains(t, string(data), "common.json")
+}
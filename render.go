@@ -7,6 +7,7 @@ package schemadoc
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 )
@@ -20,14 +21,59 @@ const (
 	defaultWrapWidth = 80
 	// defaultListMarker is used when caller does not provide list marker style.
 	defaultListMarker = "*"
+	// defaultNestedDepth limits inline nested object expansion when caller does not set depth.
+	defaultNestedDepth = 5
+	// additionalPropertiesKey is synthetic key of additionalProperties entries; their path segment is `[]`.
+	additionalPropertiesKey = "<name>"
 )
 
 const (
-	templateListName  = "list"
-	templateTableName = "table"
+	templateListName     = "list"
+	templateTableName    = "table"
+	templateHTMLName     = "html"
+	templateAsciiDocName = "asciidoc"
+	templateRSTName      = "rst"
+	templateManName      = "man"
 )
 
+const (
+	// OutputFormatMarkdown renders CommonMark document with text/template.
+	OutputFormatMarkdown OutputFormat = "markdown"
+	// OutputFormatHTML renders standalone HTML page with html/template.
+	OutputFormatHTML OutputFormat = "html"
+	// OutputFormatAsciiDoc renders AsciiDoc document with text/template.
+	OutputFormatAsciiDoc OutputFormat = "asciidoc"
+	// OutputFormatRST renders reStructuredText document with text/template.
+	OutputFormatRST OutputFormat = "rst"
+	// OutputFormatMan renders roff man page with text/template.
+	OutputFormatMan OutputFormat = "man"
+)
+
+// builtInTemplateFormats maps built-in template names to output format they produce.
+var builtInTemplateFormats = map[string]OutputFormat{
+	templateListName:     OutputFormatMarkdown,
+	templateTableName:    OutputFormatMarkdown,
+	templateHTMLName:     OutputFormatHTML,
+	templateAsciiDocName: OutputFormatAsciiDoc,
+	templateRSTName:      OutputFormatRST,
+	templateManName:      OutputFormatMan,
+}
+
+// defaultFormatTemplates maps output formats to built-in template used when caller does not provide one.
+var defaultFormatTemplates = map[OutputFormat]string{
+	OutputFormatMarkdown: defaultTemplateName,
+	OutputFormatHTML:     templateHTMLName,
+	OutputFormatAsciiDoc: templateAsciiDocName,
+	OutputFormatRST:      templateRSTName,
+	OutputFormatMan:      templateManName,
+}
+
+// OutputFormat selects documentation output backend.
+type OutputFormat string
+
 // renderView is the root view model passed to markdown templates.
+//
+// IndexLink is set on definition pages of multi-page output and points back to index page.
 type renderView struct {
 	Title              string
 	SourceSchema       string
@@ -38,18 +84,38 @@ type renderView struct {
 	ListMarker         string
 	ExampleFormat      string
 	ExampleDocument    string
+	IndexLink          string
+	Man                manPageView
+	Graph              definitionGraph
+	Contents           []contentsEntry
+	Index              []indexEntry
 	Definitions        []definitionView
 }
 
+// contentsEntry is one table of contents link to definition section or page.
+type contentsEntry struct {
+	Name string
+	Href string
+}
+
 // definitionView represents one top-level definition section in markdown output.
 type definitionView struct {
 	Name          string
 	Description   string
 	Attributes    []attributeView
+	Rules         []string
 	Properties    []propertyView
+	Variants      []variantView
+	ReferencedBy  []referenceView
 	HasProperties bool
 }
 
+// referenceView is one definition referencing another one with its property paths leading to reference.
+type referenceView struct {
+	Definition string
+	Paths      []string
+}
+
 // propertyView represents one property section inside a definition.
 type propertyView struct {
 	Heading     string
@@ -57,12 +123,33 @@ type propertyView struct {
 	Paths       []string
 	Description string
 	Attributes  []attributeView
+	Rules       []string
+	Variants    []variantView
+	Depth       int
+}
+
+// variantView represents one oneOf/anyOf alternative with its own attributes and properties.
+type variantView struct {
+	Keyword     string
+	Heading     string
+	Label       string
+	Condition   string
+	Reference   string
+	Description string
+	Attributes  []attributeView
+	Rules       []string
+	Properties  []propertyView
+	Index       int
 }
 
 // attributeView is a single rendered name/value metadata item.
+//
+// Raw holds keyword form of value when Value is prose, for example constraints;
+// templates may use `{{ or .Raw .Value }}` to prefer it.
 type attributeView struct {
 	Name  string
 	Value string
+	Raw   string
 }
 
 // RenderFile reads schema from file and renders markdown documentation.
@@ -76,40 +163,113 @@ func RenderFile(path string, opt Options) (string, error) {
 		opt.SourcePath = path
 	}
 
+	if strings.TrimSpace(opt.BaseDir) == "" {
+		opt.BaseDir = filepath.Dir(path)
+	}
+
 	return Render(schemaBytes, opt)
 }
 
-// Render converts schema bytes into deterministic CommonMark document.
+// Render converts schema bytes into deterministic document in selected output format.
 func Render(schemaBytes []byte, opt Options) (string, error) {
-	doc, err := parseDocument(schemaBytes)
+	format, err := resolveOutputFormat(opt)
 	if err != nil {
 		return "", err
 	}
 
-	view, err := buildRenderView(doc, opt)
+	view, err := loadRenderView(schemaBytes, opt)
 	if err != nil {
 		return "", err
 	}
 
-	if err := applyExampleRenderView(schemaBytes, opt, &view); err != nil {
+	linker := newDocumentLinker(view.Definitions)
+	view = linker.linkView(view)
+
+	switch format {
+	case OutputFormatHTML:
+		return renderHTML(view, opt)
+	case OutputFormatAsciiDoc, OutputFormatRST:
+		return renderTextFormat(view, opt, format)
+	case OutputFormatMan:
+		applyManPageView(opt, &view)
+		return renderTextFormat(view, opt, format)
+	}
+
+	markdownTemplate, err := resolveTemplate(opt, format)
+	if err != nil {
 		return "", err
 	}
 
-	markdownTemplate, err := resolveTemplate(opt)
+	return executeMarkdownTemplate(markdownTemplate, view, linker, opt.HeadingOffset)
+}
+
+// loadRenderView decodes schema bytes and builds template view with optional embedded example.
+func loadRenderView(schemaBytes []byte, opt Options) (renderView, error) {
+	doc, err := loadDocument(schemaBytes, opt)
+	if err != nil {
+		return renderView{}, err
+	}
+
+	view, err := buildRenderView(doc, opt)
+	if err != nil {
+		return renderView{}, err
+	}
+
+	if err := applyExampleRenderView(doc, opt, &view); err != nil {
+		return renderView{}, err
+	}
+
+	return view, nil
+}
+
+// renderTextFormat executes AsciiDoc, reStructuredText or man page template with render view.
+func renderTextFormat(view renderView, opt Options, format OutputFormat) (string, error) {
+	textTemplate, err := resolveTemplate(opt, format)
 	if err != nil {
 		return "", err
 	}
 
 	var out strings.Builder
-	if err := markdownTemplate.Execute(&out, view); err != nil {
-		return "", fmt.Errorf("%w: %w", ErrExecuteMarkdownTemplate, err)
+	if err := textTemplate.Execute(&out, view); err != nil {
+		return "", fmt.Errorf("%w %s: %w", ErrExecuteTemplate, format, err)
 	}
 
-	return ensureTrailingNewline(normalizeMarkdownOutput(out.String())), nil
+	switch format {
+	case OutputFormatAsciiDoc:
+		return ensureTrailingNewline(collapseBlankLines(out.String(), isAsciiDocDelimiter)), nil
+	case OutputFormatMan:
+		return ensureTrailingNewline(normalizeRoffOutput(out.String())), nil
+	default:
+		return ensureTrailingNewline(collapseBlankLines(out.String(), nil)), nil
+	}
+}
+
+// resolveOutputFormat validates output format; empty value follows built-in template, markdown by default.
+func resolveOutputFormat(opt Options) (OutputFormat, error) {
+	templateName := normalizeTemplateName(opt.TemplateName)
+	templateFormat, builtin := builtInTemplateFormats[templateName]
+	if !builtin {
+		templateFormat = OutputFormatMarkdown
+	}
+
+	format := OutputFormat(strings.ToLower(strings.TrimSpace(string(opt.Format))))
+	if format == "" {
+		return templateFormat, nil
+	}
+
+	if _, ok := defaultFormatTemplates[format]; !ok {
+		return "", fmt.Errorf("%w %q", ErrUnknownOutputFormat, opt.Format)
+	}
+
+	if builtin && strings.TrimSpace(opt.TemplateText) == "" && format != templateFormat {
+		return "", fmt.Errorf("%w: template %q is not %s template", ErrTemplateFormatMismatch, templateName, format)
+	}
+
+	return format, nil
 }
 
 // applyExampleRenderView attaches optional example payload block to markdown template view.
-func applyExampleRenderView(schemaBytes []byte, opt Options, view *renderView) error {
+func applyExampleRenderView(doc schemaDocument, opt Options, view *renderView) error {
 	if view == nil {
 		return nil
 	}
@@ -119,12 +279,7 @@ func applyExampleRenderView(schemaBytes []byte, opt Options, view *renderView) e
 		return nil
 	}
 
-	mode := opt.ExampleMode
-	if strings.TrimSpace(string(mode)) == "" {
-		mode = ExampleModeAll
-	}
-
-	generated, err := GenerateExample(schemaBytes, mode, opt.ExampleFormat)
+	generated, err := generateExampleDocument(doc, exampleModeOrDefault(opt.ExampleMode), opt.ExampleFormat, opt.PropertyOrder)
 	if err != nil {
 		return fmt.Errorf("generate embedded example: %w", err)
 	}
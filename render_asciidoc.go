@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"strings"
+	"text/template"
+)
+
+// asciiDocSpecialChars lists characters that may start AsciiDoc inline formatting or references.
+const asciiDocSpecialChars = "*_`#^~+[]{}|\\<>"
+
+// asciiDocTemplateFuncs provides utility functions available inside AsciiDoc templates.
+//
+// View strings are markdown-escaped, so templates convert them with `markup`,
+// `inline` or `code`; raw strings such as title use `text` or `literal`.
+func asciiDocTemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"jsonInline": func(value any) string {
+			return asciiDocLiteral(mustJSONInline(value))
+		},
+		"headingAnchor": markdownHeadingAnchor,
+		"markup":        markdownAsciiDoc,
+		"inline":        inlineAsciiDoc,
+		"code": func(value string) string {
+			return asciiDocLiteral(markupPlainText("`" + value + "`"))
+		},
+		"text":    asciiDocText,
+		"literal": asciiDocLiteral,
+		"cell":    asciiDocCell,
+		"listing": asciiDocListing,
+	}
+}
+
+// isAsciiDocDelimiter reports whether trimmed line opens or closes AsciiDoc listing block.
+func isAsciiDocDelimiter(trimmed string) bool {
+	return len(trimmed) >= 4 && strings.Trim(trimmed, "-") == ""
+}
+
+// markdownAsciiDoc converts markdown description subset into AsciiDoc blocks.
+func markdownAsciiDoc(text string) string {
+	blocks := parseMarkupBlocks(text)
+	out := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		switch block.Kind {
+		case markupBulletList, markupOrderedList:
+			marker := "* "
+			if block.Kind == markupOrderedList {
+				marker = ". "
+			}
+
+			items := make([]string, 0, len(block.Items))
+			for _, item := range block.Items {
+				items = append(items, marker+guardAsciiDocLines(inlineAsciiDoc(item)))
+			}
+
+			out = append(out, strings.Join(items, "\n"))
+		case markupCodeBlock:
+			out = append(out, asciiDocListing(block.Lang, block.Text))
+		case markupQuote:
+			out = append(out, "____\n"+guardAsciiDocLines(inlineAsciiDoc(block.Text))+"\n____")
+		default:
+			out = append(out, guardAsciiDocLines(inlineAsciiDoc(block.Text)))
+		}
+	}
+
+	return strings.Join(out, "\n\n")
+}
+
+// asciiDocListing renders verbatim listing block, with source style when language is known.
+func asciiDocListing(lang, code string) string {
+	delimiter := asciiDocListingDelimiter(code)
+	listing := delimiter + "\n" + code + "\n" + delimiter
+	if lang != "" {
+		listing = "[source," + lang + "]\n" + listing
+	}
+
+	return listing
+}
+
+// asciiDocListingDelimiter returns listing block delimiter longer than any dash-only line of code.
+func asciiDocListingDelimiter(code string) string {
+	delimiter := "----"
+	for _, line := range strings.Split(code, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if isAsciiDocDelimiter(trimmed) && len(trimmed) >= len(delimiter) {
+			delimiter = strings.Repeat("-", len(trimmed)+1)
+		}
+	}
+
+	return delimiter
+}
+
+// guardAsciiDocLines prefixes lines that would start block syntax with `{empty}` attribute.
+func guardAsciiDocLines(text string) string {
+	lines := strings.Split(text, "\n")
+	for index, line := range lines {
+		if line != "" && strings.ContainsRune(".=-:/'", rune(line[0])) {
+			lines[index] = "{empty}" + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// inlineAsciiDoc converts markdown inline text such as attribute value into AsciiDoc.
+func inlineAsciiDoc(text string) string {
+	return joinMarkupPieces(asciiDocPieces(parseMarkupInline(text)), "")
+}
+
+// asciiDocPieces renders inline spans as AsciiDoc fragments.
+func asciiDocPieces(spans []markupSpan) []markupPiece {
+	out := make([]markupPiece, 0, len(spans))
+	for _, span := range spans {
+		switch span.Kind {
+		case markupCode:
+			out = append(out, markupPiece{Text: asciiDocLiteral(span.Text), Tight: asciiDocTightLiteral(span.Text), Markup: true})
+		case markupStrong:
+			out = append(out, markupPiece{Text: "**" + inlineAsciiDocSpans(span.Children) + "**"})
+		case markupLink:
+			out = append(out, markupPiece{Text: asciiDocLink(span), Markup: true})
+		default:
+			out = append(out, markupPiece{Text: asciiDocText(span.Text)})
+		}
+	}
+
+	return out
+}
+
+// inlineAsciiDocSpans renders already parsed inline spans as AsciiDoc.
+func inlineAsciiDocSpans(spans []markupSpan) string {
+	return joinMarkupPieces(asciiDocPieces(spans), "")
+}
+
+// asciiDocLink renders markdown link as cross reference for `#anchor` targets or link macro.
+func asciiDocLink(span markupSpan) string {
+	label := strings.ReplaceAll(inlineAsciiDocSpans(span.Children), "]", "\\]")
+	if anchor, ok := strings.CutPrefix(span.URL, "#"); ok {
+		return "<<" + anchor + "," + label + ">>"
+	}
+
+	url, ok := safeMarkupURL(span.URL)
+	if !ok {
+		return label
+	}
+
+	if strings.ContainsAny(url, "[] ") {
+		url = "++" + url + "++"
+	}
+
+	return "link:" + url + "[" + label + "]"
+}
+
+// asciiDocText escapes raw text; lines with special characters become `pass:c[...]` passthroughs.
+func asciiDocText(text string) string {
+	lines := strings.Split(text, "\n")
+	for index, line := range lines {
+		if !strings.ContainsAny(line, asciiDocSpecialChars) {
+			continue
+		}
+
+		lines[index] = "pass:c[" + asciiDocPassthroughContent(line) + "]"
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// asciiDocLiteral renders raw text as constrained literal monospace span.
+func asciiDocLiteral(text string) string {
+	if strings.ContainsAny(text, "+`\n") {
+		return "`pass:c[" + asciiDocPassthroughContent(text) + "]`"
+	}
+
+	return "`+" + text + "+`"
+}
+
+// asciiDocTightLiteral renders raw text as unconstrained literal monospace span used next to word characters.
+func asciiDocTightLiteral(text string) string {
+	if strings.ContainsAny(text, "+`\n") {
+		return "``pass:c[" + asciiDocPassthroughContent(text) + "]``"
+	}
+
+	return "``+" + text + "+``"
+}
+
+// asciiDocPassthroughContent escapes closing brackets and trailing backslash inside passthrough macro.
+func asciiDocPassthroughContent(text string) string {
+	text = strings.ReplaceAll(text, "\n", " ")
+	text = strings.ReplaceAll(text, "]", "\\]")
+	if strings.HasSuffix(text, "\\") {
+		text += " "
+	}
+
+	return text
+}
+
+// asciiDocCell escapes table cell separators in rendered AsciiDoc text.
+func asciiDocCell(text string) string {
+	return strings.ReplaceAll(text, "|", "\\|")
+}
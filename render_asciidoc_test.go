@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"testing"
+)
+
+func TestRenderAsciiDocConvertsDescriptionsAndEscapes(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render(markupFormatSchema(t), Options{Format: OutputFormatAsciiDoc, Title: "Config <Reference>"})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "= pass:c[Config <Reference>]\n")
+	assertContains(t, rendered, "* <<config,Config>>")
+	assertContains(t, rendered, "[#config]\n== Config\n")
+	assertContains(t, rendered, "Server `+a|b+` and **limits**, see link:https://example.com/docs[docs] or\n<<config,Config>>.")
+	assertContains(t, rendered, "* pass:c[first_item]\n* not this\n")
+	assertContains(t, rendered, "[source,yaml]\n----\nkey: value\n----")
+	assertContains(t, rendered, "[cols=\"1,3\",options=\"header\"]\n|===\n|Attribute |Value\n")
+	assertContains(t, rendered, "|Constraints\n|must match `pass:c[^a`b\\|c$]`\n")
+	assertContains(t, rendered, "[#configbackendsname]\n=== pass:c[Config.backends.<name>]")
+	assertContains(t, rendered, "Path: `+backends.[]+`")
+	assertNotContains(t, rendered, "javascript:")
+}
+
+func TestInlineAsciiDoc(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "plain", in: "plain text", want: "plain text"},
+		{name: "special", in: "a_b and c", want: "pass:c[a_b and c]"},
+		{name: "code", in: "use `x` here", want: "use `+x+` here"},
+		{name: "code in word", in: "`x`s", want: "``+x+``s"},
+		{name: "code with plus", in: "`a+b`", want: "`pass:c[a+b]`"},
+		{name: "escaped", in: "\\`literal\\`", want: "pass:c[`literal`]"},
+		{name: "anchor", in: "[Config](#config)", want: "<<config,Config>>"},
+		{name: "bracket", in: "`a]b`", want: "`+a]b+`"},
+		{name: "unsafe link", in: "[x](javascript:void(0))", want: "x"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := inlineAsciiDoc(test.in); got != test.want {
+				t.Fatalf("inlineAsciiDoc(%q) = %q, want %q", test.in, got, test.want)
+			}
+		})
+	}
+}
+
+func TestMarkdownAsciiDocGuardsBlockSyntax(t *testing.T) {
+	t.Parallel()
+
+	got := markdownAsciiDoc(".hidden title\n:attr: value\n\n```\n----\n```")
+	want := "{empty}.hidden title\n{empty}:attr: value\n\n-----\n----\n-----"
+	if got != want {
+		t.Fatalf("markdownAsciiDoc = %q, want %q", got, want)
+	}
+}
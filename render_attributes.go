@@ -225,16 +225,16 @@ func schemaAttributes(node schemaValue, required *bool) []attributeView {
 		out = append(out, attributeView{Name: "Property names", Value: summarizeSchemaLike(value)})
 	}
 
-	if value, ok := obj["dependentRequired"]; ok {
-		out = append(out, attributeView{Name: "Dependent required", Value: fmt.Sprintf("`%s`", escapeInline(mustJSONInline(value)))})
+	if values, ok := obj["dependentRequired"].(map[string]any); ok && len(values) > 0 {
+		out = append(out, attributeView{Name: "Dependent required", Value: strconv.Itoa(len(values))})
 	}
 
 	if values := mapSchemaValues(obj["dependentSchemas"]); len(values) > 0 {
 		out = append(out, attributeView{Name: "Dependent schemas", Value: strconv.Itoa(len(values))})
 	}
 
-	if value, ok := obj["dependencies"]; ok {
-		out = append(out, attributeView{Name: "Dependencies", Value: fmt.Sprintf("`%s`", escapeInline(mustJSONInline(value)))})
+	if values, ok := obj["dependencies"].(map[string]any); ok && len(values) > 0 {
+		out = append(out, attributeView{Name: "Dependencies", Value: strconv.Itoa(len(values))})
 	}
 
 	if composition := compositionSummary(obj); composition != "" {
@@ -250,7 +250,13 @@ func schemaAttributes(node schemaValue, required *bool) []attributeView {
 	}
 
 	if constraints := constraintList(obj); len(constraints) > 0 {
-		out = append(out, attributeView{Name: "Constraints", Value: strings.Join(constraints, "; ")})
+		raw := strings.Join(constraints, "; ")
+		value := strings.Join(constraintProse(obj), "; ")
+		if value == "" {
+			value = raw
+		}
+
+		out = append(out, attributeView{Name: "Constraints", Value: value, Raw: raw})
 	}
 
 	if value := asString(obj["$comment"]); value != "" {
@@ -10,17 +10,18 @@ import (
 	"testing"
 )
 
-// BenchmarkParseDocument measures schema decoding and normalization cost.
-func BenchmarkParseDocument(b *testing.B) {
+// BenchmarkLoadDocument measures schema decoding, reference resolution and normalization cost.
+func BenchmarkLoadDocument(b *testing.B) {
 	schemaPath := filepath.Join("testdata", "schema.fixture.json")
 	schemaBytes := readBenchmarkFile(b, schemaPath)
+	options := Options{SourcePath: schemaPath, BaseDir: filepath.Dir(schemaPath)}
 
 	b.ReportAllocs()
 	b.SetBytes(int64(len(schemaBytes)))
 
 	for i := 0; i < b.N; i++ {
-		if _, err := parseDocument(schemaBytes); err != nil {
-			b.Fatalf("parseDocument: %v", err)
+		if _, err := loadDocument(schemaBytes, options); err != nil {
+			b.Fatalf("loadDocument: %v", err)
 		}
 	}
 }
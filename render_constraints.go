@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// numberBound is one numeric lower or upper bound of schema value.
+type numberBound struct {
+	Value     any
+	Exclusive bool
+}
+
+// constraintProse renders validation keywords of schema node as readable phrases.
+//
+// Boolean exclusiveMinimum/exclusiveMaximum of draft-04 modify minimum/maximum,
+// numeric ones of draft-06 and later are bounds on their own.
+func constraintProse(node map[string]any) []string {
+	out := make([]string, 0, 6)
+
+	lower, hasLower := numberLowerBound(node)
+	upper, hasUpper := numberUpperBound(node)
+	if phrase := numberRangeText(lower, hasLower, upper, hasUpper); phrase != "" {
+		out = append(out, phrase)
+	}
+
+	if value, ok := node["multipleOf"]; ok {
+		out = append(out, "multiple of "+numberText(value))
+	}
+
+	if phrase := countRangeText(node["minLength"], node["maxLength"], "character", "characters"); phrase != "" {
+		out = append(out, phrase)
+	}
+
+	if value := asString(node["pattern"]); value != "" {
+		out = append(out, fmt.Sprintf("must match `%s`", escapeInline(value)))
+	}
+
+	unique, _ := asBool(node["uniqueItems"])
+	items := countRangeText(node["minItems"], node["maxItems"], "item", "items")
+	switch {
+	case items != "" && unique:
+		out = append(out, items+", unique")
+	case items != "":
+		out = append(out, items)
+	case unique:
+		out = append(out, "unique items")
+	}
+
+	if phrase := countRangeText(node["minContains"], node["maxContains"], "matching item", "matching items"); phrase != "" {
+		out = append(out, phrase)
+	}
+
+	if phrase := countRangeText(node["minProperties"], node["maxProperties"], "property", "properties"); phrase != "" {
+		out = append(out, phrase)
+	}
+
+	return out
+}
+
+// numberLowerBound returns stricter of minimum and exclusiveMinimum.
+func numberLowerBound(node map[string]any) (numberBound, bool) {
+	return numberBoundOf(node, "minimum", "exclusiveMinimum", func(exclusive, inclusive float64) bool {
+		return exclusive >= inclusive
+	})
+}
+
+// numberUpperBound returns stricter of maximum and exclusiveMaximum.
+func numberUpperBound(node map[string]any) (numberBound, bool) {
+	return numberBoundOf(node, "maximum", "exclusiveMaximum", func(exclusive, inclusive float64) bool {
+		return exclusive <= inclusive
+	})
+}
+
+// numberBoundOf resolves inclusive keyword and its exclusive counterpart into one bound.
+//
+// Stricter reports whether numeric exclusive bound wins over inclusive one.
+func numberBoundOf(node map[string]any, inclusiveKey, exclusiveKey string, stricter func(exclusive, inclusive float64) bool) (numberBound, bool) {
+	inclusive, hasInclusive := node[inclusiveKey]
+	exclusive, hasExclusive := node[exclusiveKey]
+
+	if flag, ok := exclusive.(bool); ok {
+		if !hasInclusive {
+			return numberBound{}, false
+		}
+
+		return numberBound{Value: inclusive, Exclusive: flag}, true
+	}
+
+	switch {
+	case hasInclusive && hasExclusive:
+		exclusiveNumber, exclusiveOK := numberFloat(exclusive)
+		inclusiveNumber, inclusiveOK := numberFloat(inclusive)
+		if exclusiveOK && inclusiveOK && !stricter(exclusiveNumber, inclusiveNumber) {
+			return numberBound{Value: inclusive}, true
+		}
+
+		return numberBound{Value: exclusive, Exclusive: true}, true
+	case hasExclusive:
+		return numberBound{Value: exclusive, Exclusive: true}, true
+	case hasInclusive:
+		return numberBound{Value: inclusive}, true
+	default:
+		return numberBound{}, false
+	}
+}
+
+// numberRangeText renders numeric bounds as "between 1 and 10 (inclusive)", "at least 1" and similar.
+func numberRangeText(lower numberBound, hasLower bool, upper numberBound, hasUpper bool) string {
+	switch {
+	case hasLower && hasUpper:
+		lowerText := numberText(lower.Value)
+		upperText := numberText(upper.Value)
+		switch {
+		case !lower.Exclusive && !upper.Exclusive:
+			if lowerText == upperText {
+				return "exactly " + lowerText
+			}
+
+			return fmt.Sprintf("between %s and %s (inclusive)", lowerText, upperText)
+		case lower.Exclusive && upper.Exclusive:
+			return fmt.Sprintf("between %s and %s (exclusive)", lowerText, upperText)
+		default:
+			return lowerBoundText(lower) + " and " + upperBoundText(upper)
+		}
+	case hasLower:
+		return lowerBoundText(lower)
+	case hasUpper:
+		return upperBoundText(upper)
+	default:
+		return ""
+	}
+}
+
+// lowerBoundText renders "at least N" or "greater than N".
+func lowerBoundText(bound numberBound) string {
+	if bound.Exclusive {
+		return "greater than " + numberText(bound.Value)
+	}
+
+	return "at least " + numberText(bound.Value)
+}
+
+// upperBoundText renders "at most N" or "less than N".
+func upperBoundText(bound numberBound) string {
+	if bound.Exclusive {
+		return "less than " + numberText(bound.Value)
+	}
+
+	return "at most " + numberText(bound.Value)
+}
+
+// countRangeText renders min/max count keywords as "between 1 and 3 items", "exactly 1 item" and similar.
+func countRangeText(minValue, maxValue any, singular, plural string) string {
+	noun := func(value any) string {
+		if numberText(value) == "1" {
+			return singular
+		}
+
+		return plural
+	}
+
+	switch {
+	case minValue != nil && maxValue != nil:
+		minText := numberText(minValue)
+		maxText := numberText(maxValue)
+		if minText == maxText {
+			return fmt.Sprintf("exactly %s %s", minText, noun(minValue))
+		}
+
+		return fmt.Sprintf("between %s and %s %s", minText, maxText, plural)
+	case minValue != nil:
+		return fmt.Sprintf("at least %s %s", numberText(minValue), noun(minValue))
+	case maxValue != nil:
+		return fmt.Sprintf("at most %s %s", numberText(maxValue), noun(maxValue))
+	default:
+		return ""
+	}
+}
+
+// numberText renders JSON number as inline text.
+func numberText(value any) string {
+	return escapeInline(mustJSONInline(value))
+}
+
+// numberFloat converts decoded JSON number into float64 for bound comparison.
+func numberFloat(value any) (float64, bool) {
+	text := mustJSONInline(value)
+	number, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return number, true
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestConstraintProse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		node map[string]any
+		want string
+	}{
+		{
+			name: "inclusive range",
+			node: map[string]any{"minimum": json.Number("1"), "maximum": json.Number("65535")},
+			want: "between 1 and 65535 (inclusive)",
+		},
+		{
+			name: "draft-04 boolean exclusive minimum",
+			node: map[string]any{"minimum": json.Number("0"), "exclusiveMinimum": true, "maximum": json.Number("1")},
+			want: "greater than 0 and at most 1",
+		},
+		{
+			name: "draft-04 boolean exclusive false",
+			node: map[string]any{"maximum": json.Number("10"), "exclusiveMaximum": false},
+			want: "at most 10",
+		},
+		{
+			name: "numeric exclusive bounds",
+			node: map[string]any{"exclusiveMinimum": json.Number("0"), "exclusiveMaximum": json.Number("1")},
+			want: "between 0 and 1 (exclusive)",
+		},
+		{
+			name: "stricter numeric exclusive bound wins",
+			node: map[string]any{"minimum": json.Number("5"), "exclusiveMinimum": json.Number("3")},
+			want: "at least 5",
+		},
+		{
+			name: "multiple of",
+			node: map[string]any{"multipleOf": json.Number("5")},
+			want: "multiple of 5",
+		},
+		{
+			name: "string length and pattern",
+			node: map[string]any{"minLength": json.Number("1"), "maxLength": json.Number("1"), "pattern": "^[a-z]+$"},
+			want: "exactly 1 character; must match `^[a-z]+$`",
+		},
+		{
+			name: "unique items",
+			node: map[string]any{"maxItems": json.Number("3"), "uniqueItems": true},
+			want: "at most 3 items, unique",
+		},
+		{
+			name: "properties and contains",
+			node: map[string]any{"minContains": json.Number("2"), "minProperties": json.Number("1")},
+			want: "at least 2 matching items; at least 1 property",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := strings.Join(constraintProse(test.node), "; "); got != test.want {
+				t.Fatalf("constraintProse() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSchemaAttributesKeepRawConstraints(t *testing.T) {
+	t.Parallel()
+
+	attributes := schemaAttributes(schemaValue{Object: map[string]any{
+		"minimum":          json.Number("1"),
+		"exclusiveMinimum": true,
+	}}, nil)
+
+	for _, attribute := range attributes {
+		if attribute.Name != "Constraints" {
+			continue
+		}
+
+		if attribute.Value != "greater than 1" {
+			t.Fatalf("Value = %q, want prose", attribute.Value)
+		}
+
+		if attribute.Raw != "minimum=1; exclusiveMinimum=true" {
+			t.Fatalf("Raw = %q, want keyword form", attribute.Raw)
+		}
+
+		return
+	}
+
+	t.Fatalf("missing Constraints attribute in %+v", attributes)
+}
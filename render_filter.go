@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// filterDefinitions returns definitions and rendering order kept by Options definition filters.
+//
+// Root definition, when set, is always kept. Exclude globs win over include globs.
+// With DropOrphans, definitions not reachable from root through kept definitions are dropped;
+// without root no definition is an orphan.
+func filterDefinitions(definitions map[string]schemaValue, order []string, root string, opt Options) (map[string]schemaValue, []string, error) {
+	for _, pattern := range append(append([]string(nil), opt.IncludeDefinitions...), opt.ExcludeDefinitions...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, nil, fmt.Errorf("%w %q: %w", ErrInvalidDefinitionPattern, pattern, err)
+		}
+	}
+
+	if len(order) == 0 || (len(opt.IncludeDefinitions) == 0 && len(opt.ExcludeDefinitions) == 0 && !opt.DropOrphans) {
+		return definitions, order, nil
+	}
+
+	kept := make(map[string]schemaValue, len(definitions))
+	for _, name := range order {
+		if (root != "" && name == root) || definitionSelected(name, opt.IncludeDefinitions, opt.ExcludeDefinitions) {
+			kept[name] = definitions[name]
+		}
+	}
+
+	if _, ok := kept[root]; ok && opt.DropOrphans {
+		kept = reachableDefinitions(kept, root)
+	}
+
+	filtered := make([]string, 0, len(kept))
+	for _, name := range order {
+		if _, ok := kept[name]; ok {
+			filtered = append(filtered, name)
+		}
+	}
+
+	return kept, filtered, nil
+}
+
+// definitionSelected reports whether definition name matches include globs, if any, and no exclude glob.
+func definitionSelected(name string, include, exclude []string) bool {
+	for _, pattern := range exclude {
+		if matched, _ := path.Match(pattern, name); matched {
+			return false
+		}
+	}
+
+	if len(include) == 0 {
+		return true
+	}
+
+	for _, pattern := range include {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reachableDefinitions returns definitions referenced directly or transitively from root definition.
+//
+// Every local `$ref` counts, including ones in compositions and conditionals
+// that do not produce property paths.
+func reachableDefinitions(definitions map[string]schemaValue, root string) map[string]schemaValue {
+	reachable := map[string]schemaValue{root: definitions[root]}
+	queue := []string{root}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, target := range referencedDefinitions(definitions[current]) {
+			node, ok := definitions[target]
+			if !ok {
+				continue
+			}
+
+			if _, seen := reachable[target]; seen {
+				continue
+			}
+
+			reachable[target] = node
+			queue = append(queue, target)
+		}
+	}
+
+	return reachable
+}
+
+// referencedDefinitions returns names of definitions referenced anywhere inside schema node.
+//
+// Definitions nested in `$defs` of node itself are not references, so they are skipped.
+func referencedDefinitions(node schemaValue) []string {
+	if node.Object == nil {
+		return nil
+	}
+
+	var targets []string
+	var visit func(pointer string, object map[string]any)
+	visit = func(pointer string, object map[string]any) {
+		if target := rootDefinitionName(schemaReference(object)); target != "" {
+			targets = append(targets, target)
+		}
+
+		forEachSubschema(object, pointer, func(childPointer string, child map[string]any) {
+			if pointer == "" && (strings.HasPrefix(childPointer, "/$defs/") || strings.HasPrefix(childPointer, "/definitions/")) {
+				return
+			}
+
+			visit(childPointer, child)
+		})
+	}
+
+	visit("", node.Object)
+	return targets
+}
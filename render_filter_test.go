@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"errors"
+	"testing"
+)
+
+func filterSchemaBytes(t *testing.T) []byte {
+	t.Helper()
+
+	return minimalSchemaBytes(t, map[string]any{
+		"$ref": "#/$defs/Config",
+		"$defs": map[string]any{
+			"Config": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"server": map[string]any{"$ref": "#/$defs/Server"},
+					"store": map[string]any{"oneOf": []any{
+						map[string]any{"$ref": "#/$defs/Disk"},
+						map[string]any{"type": "string"},
+					}},
+				},
+				"if":   map[string]any{"required": []any{"server"}},
+				"then": map[string]any{"$ref": "#/$defs/Limits"},
+			},
+			"Server": map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"port": map[string]any{"type": "integer"}},
+			},
+			"Disk": map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"path": map[string]any{"type": "string"}},
+			},
+			"Limits": map[string]any{"type": "object"},
+			"internalState": map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"helper": map[string]any{"$ref": "#/$defs/Helper"}},
+			},
+			"Helper": map[string]any{"type": "object"},
+		},
+	})
+}
+
+func TestRenderFiltersDefinitionsByGlob(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render(filterSchemaBytes(t), Options{
+		IncludeDefinitions: []string{"S*", "D*", "[Hi]*"},
+		ExcludeDefinitions: []string{"Disk", "Config"},
+	})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "## Config\n")
+	assertContains(t, rendered, "## Server\n")
+	assertContains(t, rendered, "## internalState\n")
+	assertContains(t, rendered, "## Helper\n")
+	assertNotContains(t, rendered, "## Disk")
+	assertNotContains(t, rendered, "## Limits")
+
+	assertContains(t, rendered, "Reference: [`#/$defs/Server`](#server)")
+	assertContains(t, rendered, "Reference: `#/$defs/Disk`")
+	assertNotContains(t, rendered, "See [Disk]")
+	assertNotContains(t, rendered, "(#disk)")
+}
+
+func TestRenderDropsOrphanDefinitions(t *testing.T) {
+	t.Parallel()
+
+	view, err := BuildView(filterSchemaBytes(t), Options{DropOrphans: true})
+	if err != nil {
+		t.Fatalf("BuildView: %v", err)
+	}
+
+	names := make([]string, 0, len(view.Definitions))
+	for _, definition := range view.Definitions {
+		names = append(names, definition.Name.Raw)
+	}
+
+	want := []string{"Config", "Disk", "Limits", "Server"}
+	if len(names) != len(want) {
+		t.Fatalf("definitions = %v, want %v", names, want)
+	}
+
+	for index := range want {
+		if names[index] != want[index] {
+			t.Fatalf("definitions = %v, want %v", names, want)
+		}
+	}
+
+	graph, err := RenderDOT(filterSchemaBytes(t), Options{DropOrphans: true, ExcludeDefinitions: []string{"Server"}})
+	if err != nil {
+		t.Fatalf("RenderDOT: %v", err)
+	}
+
+	assertContains(t, graph, `"Config" -> "Disk"`)
+	assertNotContains(t, graph, `"Server"`)
+	assertNotContains(t, graph, `"Helper"`)
+}
+
+func TestRenderRejectsInvalidDefinitionPattern(t *testing.T) {
+	t.Parallel()
+
+	_, err := Render(filterSchemaBytes(t), Options{ExcludeDefinitions: []string{"["}})
+	if !errors.Is(err, ErrInvalidDefinitionPattern) {
+		t.Fatalf("Render error = %v, want %v", err, ErrInvalidDefinitionPattern)
+	}
+}
@@ -0,0 +1,396 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// diagramFlowchart is Mermaid flowchart diagram kind.
+	diagramFlowchart = "flowchart"
+	// diagramClass is Mermaid class diagram kind.
+	diagramClass = "classDiagram"
+	// cycleEdgeColor is stroke color of edges that are part of reference cycle.
+	cycleEdgeColor = "#b03a2e"
+)
+
+// mermaidLabelEscaper replaces characters with special meaning inside quoted Mermaid labels with entity codes.
+var mermaidLabelEscaper = strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;")
+
+// dotLabelEscaper escapes characters with special meaning inside quoted DOT strings.
+var dotLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// definitionGraph is definition reference graph reachable from root definition.
+type definitionGraph struct {
+	Root  string
+	Nodes []graphNode
+	Edges []graphEdge
+}
+
+// graphNode is one definition in reference graph with its distance from root definition.
+type graphNode struct {
+	Name  string
+	ID    string
+	Depth int
+}
+
+// graphEdge is one reference between definitions labeled with property paths of source definition.
+//
+// Cycle marks edges between definitions that can reach each other, including self references.
+type graphEdge struct {
+	From  string
+	To    string
+	Label string
+	Cycle bool
+}
+
+// RenderDOT converts schema bytes into Graphviz DOT digraph of definition references.
+//
+// Nodes are definitions reachable from root definition within Options.DiagramDepth
+// reference hops; edges are labeled with property paths and cycle edges are dashed.
+func RenderDOT(schemaBytes []byte, opt Options) (string, error) {
+	doc, err := loadDocument(schemaBytes, opt)
+	if err != nil {
+		return "", err
+	}
+
+	graph, err := buildDocumentGraph(doc, opt)
+	if err != nil {
+		return "", err
+	}
+
+	title := strings.TrimSpace(opt.Title)
+	if title == "" {
+		title = defaultTitle
+	}
+
+	return dotDiagram(graph, sanitizeText(title)), nil
+}
+
+// RenderDOTFile reads schema from file and converts it into Graphviz DOT digraph.
+func RenderDOTFile(path string, opt Options) (string, error) {
+	schemaBytes, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrReadSchemaFile, err)
+	}
+
+	if strings.TrimSpace(opt.BaseDir) == "" {
+		opt.BaseDir = filepath.Dir(path)
+	}
+
+	return RenderDOT(schemaBytes, opt)
+}
+
+// buildDocumentGraph selects root definition of document and builds its reference graph.
+func buildDocumentGraph(doc schemaDocument, opt Options) (definitionGraph, error) {
+	definitions, rootName, err := renderDefinitions(doc, opt.RootDefinition)
+	if err != nil {
+		return definitionGraph{}, err
+	}
+
+	order := definitionOrder(definitions, rootName)
+	if len(order) == 0 {
+		return definitionGraph{}, errors.New("schema has no definitions to render")
+	}
+
+	definitions, order, err = filterDefinitions(definitions, order, rootName, opt)
+	if err != nil {
+		return definitionGraph{}, err
+	}
+
+	return buildDefinitionGraph(definitions, order, rootName, opt.DiagramDepth), nil
+}
+
+// buildDefinitionGraph walks definition edges breadth-first from root definition.
+//
+// Without root, walks start from definitions no other definition references, then from
+// definitions of order not reached yet, so every definition is shown.
+// Depth limits reference hops from walk start; zero or negative value does not limit depth.
+// Edges between the same definitions are merged and their paths joined into one label.
+func buildDefinitionGraph(definitions map[string]schemaValue, definitionOrder []string, root string, depth int) definitionGraph {
+	graph := definitionGraph{Root: root}
+	starts := []string{root}
+	if _, ok := definitions[root]; !ok {
+		if root != "" {
+			return graph
+		}
+
+		starts = graphEntryDefinitions(definitions, definitionOrder)
+	}
+
+	adjacency := make(map[string][]definitionEdge)
+	distance := make(map[string]int)
+	order := make([]string, 0, len(definitions))
+	for _, start := range starts {
+		if _, seen := distance[start]; seen {
+			continue
+		}
+
+		distance[start] = 0
+		order = append(order, start)
+		for position := len(order) - 1; position < len(order); position++ {
+			current := order[position]
+			for _, edge := range definitionEdges(definitions[current]) {
+				if _, ok := definitions[edge.Target]; !ok {
+					continue
+				}
+
+				adjacency[current] = append(adjacency[current], edge)
+				if _, seen := distance[edge.Target]; !seen {
+					distance[edge.Target] = distance[current] + 1
+					order = append(order, edge.Target)
+				}
+			}
+		}
+	}
+
+	cyclic := cyclicDefinitions(adjacency, order)
+	ids := make(map[string]struct{})
+	included := make(map[string]bool)
+
+	for _, name := range order {
+		if depth > 0 && distance[name] > depth {
+			continue
+		}
+
+		included[name] = true
+		graph.Nodes = append(graph.Nodes, graphNode{Name: name, ID: diagramNodeID(name, ids), Depth: distance[name]})
+	}
+
+	for _, node := range graph.Nodes {
+		if depth > 0 && node.Depth >= depth {
+			continue
+		}
+
+		edgeIndex := make(map[string]int)
+		for _, edge := range adjacency[node.Name] {
+			if !included[edge.Target] {
+				continue
+			}
+
+			if index, ok := edgeIndex[edge.Target]; ok {
+				graph.Edges[index].Label += ", " + edge.Path
+				continue
+			}
+
+			edgeIndex[edge.Target] = len(graph.Edges)
+			graph.Edges = append(graph.Edges, graphEdge{
+				From:  node.Name,
+				To:    edge.Target,
+				Label: edge.Path,
+				Cycle: edge.Target == node.Name || (cyclic[node.Name] != 0 && cyclic[node.Name] == cyclic[edge.Target]),
+			})
+		}
+	}
+
+	return graph
+}
+
+// graphEntryDefinitions returns definitions of order referenced by no other definition, followed by whole order.
+func graphEntryDefinitions(definitions map[string]schemaValue, order []string) []string {
+	referenced := make(map[string]bool)
+	for _, name := range order {
+		for _, edge := range definitionEdges(definitions[name]) {
+			if edge.Target != name {
+				referenced[edge.Target] = true
+			}
+		}
+	}
+
+	starts := make([]string, 0, 2*len(order))
+	for _, name := range order {
+		if !referenced[name] {
+			starts = append(starts, name)
+		}
+	}
+
+	return append(starts, order...)
+}
+
+// cyclicDefinitions maps definitions of multi-definition strongly connected components to component number.
+//
+// Definitions outside of cycles are absent, so equal non-zero numbers mean definitions reach each other.
+func cyclicDefinitions(adjacency map[string][]definitionEdge, order []string) map[string]int {
+	index := make(map[string]int)
+	lowLink := make(map[string]int)
+	onStack := make(map[string]bool)
+	stack := make([]string, 0)
+	components := make(map[string]int)
+	counter := 0
+	component := 0
+
+	var visit func(name string)
+	visit = func(name string) {
+		counter++
+		index[name] = counter
+		lowLink[name] = counter
+		stack = append(stack, name)
+		onStack[name] = true
+
+		for _, edge := range adjacency[name] {
+			if _, visited := index[edge.Target]; !visited {
+				visit(edge.Target)
+				lowLink[name] = min(lowLink[name], lowLink[edge.Target])
+			} else if onStack[edge.Target] {
+				lowLink[name] = min(lowLink[name], index[edge.Target])
+			}
+		}
+
+		if lowLink[name] != index[name] {
+			return
+		}
+
+		members := make([]string, 0, 1)
+		for {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[last] = false
+			members = append(members, last)
+			if last == name {
+				break
+			}
+		}
+
+		if len(members) < 2 {
+			return
+		}
+
+		component++
+		for _, member := range members {
+			components[member] = component
+		}
+	}
+
+	for _, name := range order {
+		if _, visited := index[name]; !visited {
+			visit(name)
+		}
+	}
+
+	return components
+}
+
+// diagramNodeID returns unique diagram identifier of definition built from letters, digits and underscores.
+func diagramNodeID(name string, taken map[string]struct{}) string {
+	var out strings.Builder
+	out.WriteString("def_")
+	for _, r := range name {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			out.WriteRune(r)
+			continue
+		}
+
+		out.WriteByte('_')
+	}
+
+	id := out.String()
+	for suffix := 2; ; suffix++ {
+		if _, ok := taken[id]; !ok {
+			break
+		}
+
+		id = out.String() + "_" + strconv.Itoa(suffix)
+	}
+
+	taken[id] = struct{}{}
+	return id
+}
+
+// mermaidDiagram renders definition graph as fenced Mermaid `flowchart` or `classDiagram` block.
+//
+// Cycle edges are dashed; flowchart also colors them.
+func mermaidDiagram(kind string, graph definitionGraph) (string, error) {
+	switch strings.TrimSpace(kind) {
+	case "", diagramFlowchart:
+		kind = diagramFlowchart
+	case diagramClass:
+	default:
+		return "", fmt.Errorf("%w %q", ErrUnknownDiagramKind, kind)
+	}
+
+	ids := make(map[string]string, len(graph.Nodes))
+	lines := []string{"```mermaid"}
+	if kind == diagramClass {
+		lines = append(lines, diagramClass)
+	} else {
+		lines = append(lines, diagramFlowchart+" LR")
+	}
+
+	for _, node := range graph.Nodes {
+		ids[node.Name] = node.ID
+		label := mermaidLabelEscaper.Replace(node.Name)
+		if kind == diagramClass {
+			lines = append(lines, "  class "+node.ID+`["`+label+`"]`)
+		} else {
+			lines = append(lines, "  "+node.ID+`["`+label+`"]`)
+		}
+	}
+
+	cycleLinks := make([]string, 0)
+	for index, edge := range graph.Edges {
+		label := mermaidLabelEscaper.Replace(edge.Label)
+		if kind == diagramClass {
+			arrow := "-->"
+			if edge.Cycle {
+				arrow = "..>"
+			}
+
+			lines = append(lines, "  "+ids[edge.From]+" "+arrow+" "+ids[edge.To]+" : "+label)
+			continue
+		}
+
+		arrow := "-->"
+		if edge.Cycle {
+			arrow = "-.->"
+			cycleLinks = append(cycleLinks, strconv.Itoa(index))
+		}
+
+		lines = append(lines, "  "+ids[edge.From]+" "+arrow+`|"`+label+`"| `+ids[edge.To])
+	}
+
+	if len(cycleLinks) > 0 {
+		lines = append(lines, "  linkStyle "+strings.Join(cycleLinks, ",")+" stroke:"+cycleEdgeColor)
+	}
+
+	lines = append(lines, "```")
+	return strings.Join(lines, "\n"), nil
+}
+
+// dotDiagram renders definition graph as Graphviz DOT digraph; cycle edges are dashed and colored.
+func dotDiagram(graph definitionGraph, title string) string {
+	var out strings.Builder
+	out.WriteString("digraph schema {\n")
+	out.WriteString("  label=\"" + dotLabelEscaper.Replace(title) + "\";\n")
+	out.WriteString("  labelloc=t;\n")
+	out.WriteString("  rankdir=LR;\n")
+	out.WriteString("  node [shape=box];\n")
+
+	for _, node := range graph.Nodes {
+		attributes := ""
+		if node.Name == graph.Root {
+			attributes = " [style=bold]"
+		}
+
+		out.WriteString("  \"" + dotLabelEscaper.Replace(node.Name) + "\"" + attributes + ";\n")
+	}
+
+	for _, edge := range graph.Edges {
+		attributes := "label=\"" + dotLabelEscaper.Replace(edge.Label) + "\""
+		if edge.Cycle {
+			attributes += ", style=dashed, color=\"" + cycleEdgeColor + "\""
+		}
+
+		out.WriteString("  \"" + dotLabelEscaper.Replace(edge.From) + "\" -> \"" + dotLabelEscaper.Replace(edge.To) + "\" [" + attributes + "];\n")
+	}
+
+	out.WriteString("}\n")
+	return out.String()
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"errors"
+	"testing"
+)
+
+func graphSchemaBytes(t *testing.T) []byte {
+	t.Helper()
+
+	return minimalSchemaBytes(t, map[string]any{
+		"$ref": "#/$defs/Config",
+		"$defs": map[string]any{
+			"Config": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"tree":    map[string]any{"$ref": "#/$defs/Node"},
+					"servers": map[string]any{"type": "array", "items": map[string]any{"$ref": "#/$defs/Server"}},
+					"backup":  map[string]any{"$ref": "#/$defs/Server"},
+				},
+			},
+			"Node": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"children": map[string]any{"type": "array", "items": map[string]any{"$ref": "#/$defs/Node"}},
+					"owner":    map[string]any{"$ref": "#/$defs/Owner"},
+				},
+			},
+			"Owner": map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"node": map[string]any{"$ref": "#/$defs/Node"}},
+			},
+			"Server": map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"tls": map[string]any{"$ref": "#/$defs/TLS \"v2\""}},
+			},
+			"TLS \"v2\"": map[string]any{"type": "object"},
+			"Unused":     map[string]any{"type": "object"},
+		},
+	})
+}
+
+func TestRenderDOTMarksCycles(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := RenderDOT(graphSchemaBytes(t), Options{Title: "Demo"})
+	if err != nil {
+		t.Fatalf("RenderDOT: %v", err)
+	}
+
+	assertContains(t, rendered, "digraph schema {\n  label=\"Demo\";\n")
+	assertContains(t, rendered, "  \"Config\" [style=bold];\n")
+	assertContains(t, rendered, "  \"Config\" -> \"Server\" [label=\"backup, servers.[]\"];\n")
+	assertContains(t, rendered, "  \"Config\" -> \"Node\" [label=\"tree\"];\n")
+	assertContains(t, rendered, "  \"Node\" -> \"Node\" [label=\"children.[]\", style=dashed, color=\"#b03a2e\"];\n")
+	assertContains(t, rendered, "  \"Node\" -> \"Owner\" [label=\"owner\", style=dashed")
+	assertContains(t, rendered, "  \"Owner\" -> \"Node\" [label=\"node\", style=dashed")
+	assertContains(t, rendered, "  \"Server\" -> \"TLS \\\"v2\\\"\" [label=\"tls\"];\n")
+	assertNotContains(t, rendered, "Unused")
+}
+
+func TestRenderDOTLimitsDepth(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := RenderDOT(graphSchemaBytes(t), Options{DiagramDepth: 1})
+	if err != nil {
+		t.Fatalf("RenderDOT: %v", err)
+	}
+
+	assertContains(t, rendered, "\"Config\" -> \"Node\"")
+	assertContains(t, rendered, "\"Config\" -> \"Server\"")
+	assertNotContains(t, rendered, "Owner")
+	assertNotContains(t, rendered, "TLS")
+	assertNotContains(t, rendered, "\"Node\" -> \"Node\"")
+}
+
+func TestRenderMermaidTemplateFunction(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render(graphSchemaBytes(t), Options{
+		TemplateText: `{{ mermaid "flowchart" .Graph }}` + "\n\n" + `{{ mermaid "classDiagram" .Graph }}`,
+	})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "```mermaid\nflowchart LR\n  def_Config[\"Config\"]\n")
+	assertContains(t, rendered, "  def_TLS__v2_[\"TLS #quot;v2#quot;\"]\n")
+	assertContains(t, rendered, "  def_Config -->|\"backup, servers.[]\"| def_Server\n")
+	assertContains(t, rendered, "  def_Node -.->|\"children.[]\"| def_Node\n")
+	assertContains(t, rendered, "  linkStyle 2,3,5 stroke:#b03a2e\n")
+	assertContains(t, rendered, "classDiagram\n  class def_Config[\"Config\"]\n")
+	assertContains(t, rendered, "  def_Node ..> def_Owner : owner\n")
+
+	_, err = Render(graphSchemaBytes(t), Options{TemplateText: `{{ mermaid "sequence" .Graph }}`})
+	if !errors.Is(err, ErrUnknownDiagramKind) {
+		t.Fatalf("Render unknown diagram error = %v, want ErrUnknownDiagramKind", err)
+	}
+}
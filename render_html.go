@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	_ "embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+)
+
+// defaultStylesheet is embedded CSS of built-in HTML template.
+//
+//go:embed templates/style.css
+var defaultStylesheet string
+
+// resolveHTMLTemplate resolves either custom or built-in template text into a parsed HTML template.
+func resolveHTMLTemplate(opt Options) (*htmltemplate.Template, error) {
+	templateText := strings.TrimSpace(opt.TemplateText)
+	if templateText != "" {
+		return htmltemplate.New("custom").Funcs(htmlTemplateFuncs()).Parse(templateText)
+	}
+
+	templateName := normalizeTemplateName(opt.TemplateName)
+	if templateName == "" {
+		templateName = defaultFormatTemplates[OutputFormatHTML]
+	}
+
+	templateText, err := BuiltinTemplate(templateName)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := htmltemplate.New(templateName).Funcs(htmlTemplateFuncs()).Parse(templateText)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrParseBuiltinTemplate, templateName, err)
+	}
+
+	return parsed, nil
+}
+
+// renderHTML executes HTML template with render view.
+func renderHTML(view renderView, opt Options) (string, error) {
+	pageTemplate, err := resolveHTMLTemplate(opt)
+	if err != nil {
+		return "", err
+	}
+
+	var out strings.Builder
+	if err := pageTemplate.Execute(&out, view); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrExecuteHTMLTemplate, err)
+	}
+
+	return ensureTrailingNewline(out.String()), nil
+}
+
+// htmlTemplateFuncs provides utility functions available inside HTML templates.
+//
+// View strings are markdown-escaped, so templates convert them with `markdown`,
+// `inline` or `plain` instead of printing them directly.
+func htmlTemplateFuncs() htmltemplate.FuncMap {
+	return htmltemplate.FuncMap{
+		"jsonInline": func(value any) string {
+			return mustJSONInline(value)
+		},
+		"headingAnchor": markdownHeadingAnchor,
+		"markdown":      markdownHTML,
+		"inline":        inlineHTML,
+		"plain":         markupPlainText,
+		"stylesheet": func() htmltemplate.CSS {
+			//nolint:gosec // Embedded stylesheet is package constant, not user input.
+			return htmltemplate.CSS(defaultStylesheet)
+		},
+	}
+}
+
+// markdownHTML converts markdown description subset into escaped HTML blocks.
+func markdownHTML(text string) htmltemplate.HTML {
+	var out strings.Builder
+	for _, block := range parseMarkupBlocks(text) {
+		switch block.Kind {
+		case markupBulletList, markupOrderedList:
+			tag := "ul"
+			if block.Kind == markupOrderedList {
+				tag = "ol"
+			}
+
+			out.WriteString("<" + tag + ">")
+			for _, item := range block.Items {
+				out.WriteString("<li>")
+				writeSpansHTML(&out, parseMarkupInline(item))
+				out.WriteString("</li>")
+			}
+
+			out.WriteString("</" + tag + ">\n")
+		case markupCodeBlock:
+			out.WriteString("<pre><code")
+			if block.Lang != "" {
+				out.WriteString(` class="language-` + htmltemplate.HTMLEscapeString(block.Lang) + `"`)
+			}
+
+			out.WriteString(">" + htmltemplate.HTMLEscapeString(block.Text) + "</code></pre>\n")
+		case markupQuote:
+			out.WriteString("<blockquote><p>")
+			writeSpansHTML(&out, parseMarkupInline(block.Text))
+			out.WriteString("</p></blockquote>\n")
+		default:
+			out.WriteString("<p>")
+			writeSpansHTML(&out, parseMarkupInline(block.Text))
+			out.WriteString("</p>\n")
+		}
+	}
+
+	//nolint:gosec // Every text fragment is escaped by writeSpansHTML or HTMLEscapeString.
+	return htmltemplate.HTML(out.String())
+}
+
+// inlineHTML converts markdown inline text such as attribute value into escaped HTML.
+func inlineHTML(text string) htmltemplate.HTML {
+	var out strings.Builder
+	writeSpansHTML(&out, parseMarkupInline(text))
+
+	//nolint:gosec // Every text fragment is escaped by writeSpansHTML.
+	return htmltemplate.HTML(out.String())
+}
+
+// writeSpansHTML writes inline spans as escaped HTML; links with unsafe schemes become text.
+func writeSpansHTML(out *strings.Builder, spans []markupSpan) {
+	for _, span := range spans {
+		switch span.Kind {
+		case markupCode:
+			out.WriteString("<code>" + htmltemplate.HTMLEscapeString(span.Text) + "</code>")
+		case markupStrong:
+			out.WriteString("<strong>")
+			writeSpansHTML(out, span.Children)
+			out.WriteString("</strong>")
+		case markupLink:
+			url, ok := safeMarkupURL(span.URL)
+			if !ok {
+				writeSpansHTML(out, span.Children)
+				continue
+			}
+
+			out.WriteString(`<a href="` + htmltemplate.HTMLEscapeString(url) + `">`)
+			writeSpansHTML(out, span.Children)
+			out.WriteString("</a>")
+		default:
+			out.WriteString(strings.ReplaceAll(htmltemplate.HTMLEscapeString(span.Text), "\n", " "))
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRenderHTMLEscapesContentAndLinksSections(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render(minimalSchemaBytes(t, map[string]any{
+		"$ref": "#/$defs/Config",
+		"$defs": map[string]any{
+			"Config": map[string]any{
+				"type":        "object",
+				"description": "Server <b>config</b> & **limits**.\n\n* see [docs](https://example.com/docs)\n* not [this](javascript:alert(1))",
+				"properties": map[string]any{
+					"name": map[string]any{
+						"type":    "string",
+						"default": "<script>alert(1)</script>",
+						"pattern": "^a`b$",
+					},
+					"backends": map[string]any{
+						"type":                 "object",
+						"additionalProperties": map[string]any{"type": "string"},
+					},
+				},
+			},
+		},
+	}), Options{Format: OutputFormatHTML, Title: "Config <Reference>"})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "<!DOCTYPE html>")
+	assertContains(t, rendered, "<title>Config &lt;Reference&gt;</title>")
+	assertContains(t, rendered, "<style>\n:root {")
+	assertContains(t, rendered, `<details class="definition" id="config" open>`)
+	assertContains(t, rendered, `<li><a href="#config">Config</a></li>`)
+	assertContains(t, rendered, `<section class="property" id="configname">`)
+	assertContains(t, rendered, `<a class="anchor" href="#configname"`)
+	assertContains(t, rendered, "<p>Server &lt;b&gt;config&lt;/b&gt; &amp; <strong>limits</strong>.</p>")
+	assertContains(t, rendered, `<li>see <a href="https://example.com/docs">docs</a></li>`)
+	assertContains(t, rendered, "<li>not this</li>")
+	assertContains(t, rendered, `<code>&#34;\u003cscript\u003ealert(1)\u003c/script\u003e&#34;</code>`)
+	assertContains(t, rendered, "must match <code>^a`b$</code>")
+	assertContains(t, rendered, "<h3>Config.backends.&lt;name&gt; <a")
+	assertContains(t, rendered, "Key: <code>&lt;name&gt;</code>")
+	assertNotContains(t, rendered, "<script>")
+	assertNotContains(t, rendered, "javascript:")
+}
+
+func TestRenderHTMLFormatFollowsTemplateName(t *testing.T) {
+	t.Parallel()
+
+	schema := minimalSchemaBytes(t, map[string]any{"type": "object", "properties": map[string]any{"a": map[string]any{"type": "string"}}})
+	rendered, err := Render(schema, Options{TemplateName: "html"})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "<!DOCTYPE html>")
+
+	if _, err := Render(schema, Options{TemplateName: "table", Format: OutputFormatHTML}); !errors.Is(err, ErrTemplateFormatMismatch) {
+		t.Fatalf("Render error = %v, want %v", err, ErrTemplateFormatMismatch)
+	}
+
+	if _, err := Render(schema, Options{Format: "pdf"}); !errors.Is(err, ErrUnknownOutputFormat) {
+		t.Fatalf("Render error = %v, want %v", err, ErrUnknownOutputFormat)
+	}
+}
+
+func TestRenderHTMLCustomTemplateIsEscaped(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render(minimalSchemaBytes(t, map[string]any{"type": "object"}), Options{
+		Format:       OutputFormatHTML,
+		Title:        `"><img src=x>`,
+		TemplateText: `<h1 title="{{ .Title }}">{{ .Title }}</h1>`,
+	})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "&#34;&gt;&lt;img src=x&gt;")
+	assertNotContains(t, rendered, "<img")
+}
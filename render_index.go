@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"sort"
+	"strings"
+)
+
+// indexEntry is one leaf property path of flattened settings index.
+//
+// Type, Required and Default hold markdown attribute values of documented property.
+type indexEntry struct {
+	Path        string
+	Href        string
+	Type        string
+	Required    string
+	Default     string
+	Description string
+}
+
+// buildSettingsIndex lists every leaf property path of documented definitions sorted by path.
+//
+// Path is leaf when no other documented path continues it. Direct properties of root
+// definition have no paths, so their keys are used; first documented entry of path wins.
+func buildSettingsIndex(definitions []definitionView) []indexEntry {
+	entries := make(map[string]indexEntry)
+	for index, definition := range definitions {
+		collectIndexEntries(entries, definition.Properties, index == 0)
+		for _, variant := range definition.Variants {
+			collectIndexEntries(entries, variant.Properties, false)
+		}
+	}
+
+	paths := make([]string, 0, len(entries))
+	for propertyPath := range entries {
+		paths = append(paths, propertyPath)
+	}
+
+	sort.Strings(paths)
+
+	out := make([]indexEntry, 0, len(paths))
+	for _, propertyPath := range paths {
+		next := sort.SearchStrings(paths, propertyPath+".")
+		if next < len(paths) && strings.HasPrefix(paths[next], propertyPath+".") {
+			continue
+		}
+
+		out = append(out, entries[propertyPath])
+	}
+
+	return out
+}
+
+// collectIndexEntries records index entry of every property path including variant properties.
+func collectIndexEntries(entries map[string]indexEntry, properties []propertyView, root bool) {
+	for _, property := range properties {
+		paths := property.Paths
+		if len(paths) == 0 && root && property.Depth == 0 {
+			paths = []string{property.Name}
+		}
+
+		for _, propertyPath := range paths {
+			if _, ok := entries[propertyPath]; ok {
+				continue
+			}
+
+			entry := indexEntry{
+				Path:        propertyPath,
+				Href:        "#" + markdownHeadingAnchor(property.Heading),
+				Description: indexSummary(property.Description),
+			}
+
+			for _, attribute := range property.Attributes {
+				switch attribute.Name {
+				case "Type":
+					entry.Type = attribute.Value
+				case "Reference":
+					if entry.Type == "" {
+						entry.Type = attribute.Value
+					}
+				case "Required":
+					entry.Required = attribute.Value
+				case "Default":
+					entry.Default = attribute.Value
+				}
+			}
+
+			entries[propertyPath] = entry
+		}
+
+		for _, variant := range property.Variants {
+			collectIndexEntries(entries, variant.Properties, false)
+		}
+	}
+}
+
+// indexSummary returns first paragraph of markdown description joined into one table-safe line.
+func indexSummary(description string) string {
+	paragraph, _, _ := strings.Cut(strings.TrimSpace(description), "\n\n")
+	return strings.ReplaceAll(strings.Join(strings.Fields(paragraph), " "), "|", `\|`)
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"testing"
+)
+
+func indexSchemaBytes(t *testing.T) []byte {
+	t.Helper()
+
+	return minimalSchemaBytes(t, map[string]any{
+		"$ref": "#/$defs/Config",
+		"$defs": map[string]any{
+			"Config": map[string]any{
+				"type":     "object",
+				"required": []any{"server"},
+				"properties": map[string]any{
+					"server":     map[string]any{"$ref": "#/$defs/Server"},
+					"server-tag": map[string]any{"type": "string", "description": "Tag of server | host.\n\nDetails."},
+					"timeout":    map[string]any{"$ref": "#/$defs/Duration"},
+				},
+			},
+			"Server": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"port": map[string]any{"type": "integer", "default": 8080, "description": "Listen port."},
+					"tls": map[string]any{
+						"type":       "object",
+						"properties": map[string]any{"cert_file": map[string]any{"type": "string"}},
+					},
+				},
+			},
+			"Duration": map[string]any{"type": "string"},
+		},
+	})
+}
+
+func TestRenderSettingsIndexListsLeafPaths(t *testing.T) {
+	t.Parallel()
+
+	for _, templateName := range []string{"list", "table"} {
+		rendered, err := Render(indexSchemaBytes(t), Options{TemplateName: templateName, SettingsIndex: true})
+		if err != nil {
+			t.Fatalf("Render %s: %v", templateName, err)
+		}
+
+		assertContains(t, rendered, "## All settings\n\n| Path | Type | Required | Default | Description |\n| --- | --- | --- | --- | --- |\n"+
+			"| [`server-tag`](#configserver-tag) | `string` | no |  | Tag of server \\| host. |\n"+
+			"| [`server.port`](#serverport) | `integer` | no | `8080` | Listen port. |\n"+
+			"| [`server.tls.cert_file`](#servertlscert-file) | `string` | no |  |  |\n"+
+			"| [`timeout`](#configduration) | [`#/$defs/Duration`](#duration) | no |  |  |\n")
+		assertNotContains(t, rendered, "[`server`]")
+		assertNotContains(t, rendered, "[`server.tls`]")
+	}
+
+	rendered, err := Render(indexSchemaBytes(t), Options{})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertNotContains(t, rendered, "All settings")
+}
+
+func TestRenderPagesSettingsIndexLinksPages(t *testing.T) {
+	t.Parallel()
+
+	pages, err := RenderPages(indexSchemaBytes(t), Options{SettingsIndex: true})
+	if err != nil {
+		t.Fatalf("RenderPages: %v", err)
+	}
+
+	assertContains(t, pages[0].Content, "| [`server.port`](server.md#serverport) |")
+	assertContains(t, pages[0].Content, "| [`timeout`](config.md#configduration) | [`#/$defs/Duration`](duration.md) |")
+	for _, page := range pages[1:] {
+		assertNotContains(t, page.Content, "All settings")
+	}
+}
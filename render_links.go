@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// definitionRefCodePattern matches code spans holding local `$ref` to whole definition.
+var definitionRefCodePattern = regexp.MustCompile("`(#/(?:\\$defs|definitions)/[^`/]+)`")
+
+// linkTarget is page file and heading anchor of one documented entry.
+type linkTarget struct {
+	File   string
+	Anchor string
+}
+
+// definitionLinker resolves links to definitions and property entries.
+//
+// In single-file output every definition is on current page, so links are heading anchors;
+// in multi-page output links point to page files relative to current page.
+type definitionLinker struct {
+	files   map[string]string
+	targets map[string]linkTarget
+	current string
+}
+
+// newDocumentLinker returns linker of single-file output with every definition on current page.
+//
+// Paths are not indexed, so `pathLink` keeps them plain code.
+func newDocumentLinker(definitions []definitionView) definitionLinker {
+	files := make(map[string]string, len(definitions))
+	for _, definition := range definitions {
+		files[definition.Name] = ""
+	}
+
+	return definitionLinker{files: files}
+}
+
+// newPageLinker indexes page files and property path targets of all definitions.
+//
+// Direct properties of root definition have no paths, so their keys are indexed instead.
+func newPageLinker(definitions []definitionView, files map[string]string) definitionLinker {
+	linker := definitionLinker{files: files, targets: make(map[string]linkTarget)}
+	for index, definition := range definitions {
+		file := files[definition.Name]
+		linker.collectTargets(file, definition.Properties, index == 0)
+		for _, variant := range definition.Variants {
+			linker.collectTargets(file, variant.Properties, false)
+		}
+	}
+
+	return linker
+}
+
+// collectTargets records page and anchor of every property path; first documented entry wins.
+func (linker definitionLinker) collectTargets(file string, properties []propertyView, root bool) {
+	for _, property := range properties {
+		paths := property.Paths
+		if len(paths) == 0 && root && property.Depth == 0 {
+			paths = []string{property.Name}
+		}
+
+		for _, propertyPath := range paths {
+			if _, ok := linker.targets[propertyPath]; !ok {
+				linker.targets[propertyPath] = linkTarget{File: file, Anchor: markdownHeadingAnchor(property.Heading)}
+			}
+		}
+
+		for _, variant := range property.Variants {
+			linker.collectTargets(file, variant.Properties, false)
+		}
+	}
+}
+
+// forPage returns linker producing links relative to page file.
+func (linker definitionLinker) forPage(file string) definitionLinker {
+	linker.current = file
+	return linker
+}
+
+// href returns link to target, anchor only when target is on current page.
+func (linker definitionLinker) href(target linkTarget) string {
+	if target.File == linker.current {
+		return "#" + target.Anchor
+	}
+
+	return target.File + "#" + target.Anchor
+}
+
+// definitionHref returns link to page of definition; unknown names fall back to local anchor.
+func (linker definitionLinker) definitionHref(name string) string {
+	file, ok := linker.files[name]
+	if !ok {
+		return "#" + markdownHeadingAnchor(name)
+	}
+
+	if file == linker.current {
+		return "#" + markdownHeadingAnchor(name)
+	}
+
+	return file
+}
+
+// pathLink renders property path as code span linked to entry of its parent property.
+//
+// Parent property is the one leading to path, usually on page of referencing definition;
+// array and map `[]` segments are skipped. Paths without known parent stay plain code.
+func (linker definitionLinker) pathLink(propertyPath string) string {
+	code := "`" + propertyPath + "`"
+
+	segments := strings.Split(propertyPath, ".")
+	segments = segments[:len(segments)-1]
+	for len(segments) > 0 {
+		last := segments[len(segments)-1]
+		if last != "[]" {
+			break
+		}
+
+		segments = segments[:len(segments)-1]
+	}
+
+	target, ok := linker.targets[strings.Join(segments, ".")]
+	if len(segments) == 0 || !ok {
+		return code
+	}
+
+	return "[" + code + "](" + linker.href(target) + ")"
+}
+
+// refLink renders `$ref` value as code span linked to referenced definition.
+//
+// References to anything other than whole documented local definition stay plain code.
+func (linker definitionLinker) refLink(ref string) string {
+	return linker.referenceCode("`" + escapeInline(ref) + "`")
+}
+
+// referenceCode links markdown code span holding local definition `$ref` when definition is documented.
+func (linker definitionLinker) referenceCode(code string) string {
+	name := escapeInline(rootDefinitionName(strings.Trim(code, "`")))
+	if _, ok := linker.files[name]; !ok || name == "" {
+		return code
+	}
+
+	return "[" + code + "](" + linker.definitionHref(name) + ")"
+}
+
+// templateFuncs returns markdown template link helpers bound to linker.
+func (linker definitionLinker) templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"definitionHref": linker.definitionHref,
+		"pathLink":       linker.pathLink,
+		"refLink":        linker.refLink,
+	}
+}
+
+// linkIndex returns copy of settings index with rows linked to pages of their property sections.
+func (linker definitionLinker) linkIndex(entries []indexEntry) []indexEntry {
+	if len(entries) == 0 {
+		return entries
+	}
+
+	out := make([]indexEntry, 0, len(entries))
+	for _, entry := range entries {
+		if target, ok := linker.targets[entry.Path]; ok {
+			entry.Href = linker.href(target)
+		}
+
+		entry.Type = definitionRefCodePattern.ReplaceAllStringFunc(entry.Type, linker.referenceCode)
+		out = append(out, entry)
+	}
+
+	return out
+}
+
+// linkView returns copy of view with `$ref` attribute values linked to definitions.
+func (linker definitionLinker) linkView(view renderView) renderView {
+	definitions := make([]definitionView, 0, len(view.Definitions))
+	for _, definition := range view.Definitions {
+		definitions = append(definitions, linker.linkDefinition(definition))
+	}
+
+	view.Definitions = definitions
+	view.Index = linker.linkIndex(view.Index)
+	return view
+}
+
+// linkDefinition returns copy of definition with `$ref` attribute values linked to definitions.
+func (linker definitionLinker) linkDefinition(definition definitionView) definitionView {
+	definition.Attributes = linker.linkAttributes(definition.Attributes)
+	definition.Properties = linker.linkProperties(definition.Properties)
+	definition.Variants = linker.linkVariants(definition.Variants)
+	return definition
+}
+
+// linkProperties returns copies of property views with linked attributes.
+func (linker definitionLinker) linkProperties(properties []propertyView) []propertyView {
+	if len(properties) == 0 {
+		return properties
+	}
+
+	out := make([]propertyView, 0, len(properties))
+	for _, property := range properties {
+		property.Attributes = linker.linkAttributes(property.Attributes)
+		property.Variants = linker.linkVariants(property.Variants)
+		out = append(out, property)
+	}
+
+	return out
+}
+
+// linkVariants returns copies of variant views with linked attributes.
+//
+// References to definitions that are not documented are cleared, so templates render no dead links.
+func (linker definitionLinker) linkVariants(variants []variantView) []variantView {
+	if len(variants) == 0 {
+		return variants
+	}
+
+	out := make([]variantView, 0, len(variants))
+	for _, variant := range variants {
+		if _, ok := linker.files[variant.Reference]; !ok {
+			variant.Reference = ""
+		}
+
+		variant.Attributes = linker.linkAttributes(variant.Attributes)
+		variant.Properties = linker.linkProperties(variant.Properties)
+		out = append(out, variant)
+	}
+
+	return out
+}
+
+// linkAttributes returns copies of attributes with code spans of local definition `$ref` turned into links.
+func (linker definitionLinker) linkAttributes(attributes []attributeView) []attributeView {
+	if len(attributes) == 0 {
+		return attributes
+	}
+
+	out := make([]attributeView, 0, len(attributes))
+	for _, attribute := range attributes {
+		attribute.Value = definitionRefCodePattern.ReplaceAllStringFunc(attribute.Value, linker.referenceCode)
+
+		out = append(out, attribute)
+	}
+
+	return out
+}
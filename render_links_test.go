@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"testing"
+)
+
+func linkSchemaBytes(t *testing.T) []byte {
+	t.Helper()
+
+	return minimalSchemaBytes(t, map[string]any{
+		"$ref": "#/$defs/Config",
+		"$defs": map[string]any{
+			"Config": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"tls":     map[string]any{"$ref": "#/$defs/TLS_Settings"},
+					"servers": map[string]any{"type": "array", "items": map[string]any{"$ref": "#/$defs/TLS_Settings"}},
+					"labels": map[string]any{
+						"type":                 "object",
+						"additionalProperties": map[string]any{"$ref": "#/$defs/TLS_Settings"},
+					},
+					"nested":  map[string]any{"$ref": "#/$defs/TLS_Settings/properties/cert"},
+					"missing": map[string]any{"$ref": "#/$defs/Missing"},
+				},
+			},
+			"TLS_Settings": map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"cert": map[string]any{"type": "string"}},
+			},
+		},
+	})
+}
+
+func TestRenderLinksDefinitionReferences(t *testing.T) {
+	t.Parallel()
+
+	for _, templateName := range []string{"list", "table"} {
+		rendered, err := Render(linkSchemaBytes(t), Options{TemplateName: templateName})
+		if err != nil {
+			t.Fatalf("Render %s: %v", templateName, err)
+		}
+
+		assertContains(t, rendered, "[`#/$defs/TLS_Settings`](#tls-settings)")
+		assertContains(t, rendered, "reference [`#/$defs/TLS_Settings`](#tls-settings)")
+		assertContains(t, rendered, "`#/$defs/TLS_Settings/properties/cert`")
+		assertNotContains(t, rendered, "[`#/$defs/TLS_Settings/properties/cert`]")
+		assertNotContains(t, rendered, "[`#/$defs/Missing`]")
+	}
+}
+
+func TestRenderLinksDefinitionReferencesInOtherFormats(t *testing.T) {
+	t.Parallel()
+
+	html, err := Render(linkSchemaBytes(t), Options{Format: OutputFormatHTML})
+	if err != nil {
+		t.Fatalf("Render html: %v", err)
+	}
+
+	assertContains(t, html, `<a href="#tls-settings"><code>#/$defs/TLS_Settings</code></a>`)
+
+	adoc, err := Render(linkSchemaBytes(t), Options{Format: OutputFormatAsciiDoc})
+	if err != nil {
+		t.Fatalf("Render asciidoc: %v", err)
+	}
+
+	assertContains(t, adoc, "<<tls-settings,")
+}
+
+func TestRefLinkTemplateFunction(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render(linkSchemaBytes(t), Options{
+		TemplateText: `{{ refLink "#/$defs/TLS_Settings" }} {{ refLink "#/$defs/Missing" }} {{ refLink "https://example.com/schema.json" }}`,
+	})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "[`#/$defs/TLS_Settings`](#tls-settings) `#/$defs/Missing` `https://example.com/schema.json`")
+}
@@ -0,0 +1,238 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	// defaultManSection is man page section used when caller does not set one, file formats.
+	defaultManSection = "5"
+	// defaultManSummary is NAME section summary used when root definition has no description.
+	defaultManSummary = "configuration file format"
+)
+
+// manVolumes maps standard man page sections to volume titles of `.TH` header.
+var manVolumes = map[string]string{
+	"1": "General Commands Manual",
+	"4": "Kernel Interfaces Manual",
+	"5": "File Formats Manual",
+	"7": "Miscellaneous Information Manual",
+	"8": "System Manager's Manual",
+}
+
+// roffTextEscaper escapes characters with special meaning in roff text.
+var roffTextEscaper = strings.NewReplacer(`\`, `\e`, "-", `\-`)
+
+// manPageView holds `.TH` header fields and NAME summary of man page output.
+type manPageView struct {
+	Name    string
+	Section string
+	Date    string
+	Source  string
+	Manual  string
+	Summary string
+}
+
+// applyManPageView fills man page header from options and summary from root definition.
+func applyManPageView(opt Options, view *renderView) {
+	section := strings.TrimSpace(opt.ManSection)
+	if section == "" {
+		section = defaultManSection
+	}
+
+	summary := ""
+	if len(view.Definitions) > 0 {
+		summary = manSummary(view.Definitions[0].Description)
+	}
+
+	if summary == "" {
+		summary = defaultManSummary
+	}
+
+	view.Man = manPageView{
+		Name:    strings.ToUpper(view.Title),
+		Section: section,
+		Date:    strings.TrimSpace(opt.ManDate),
+		Source:  strings.TrimSpace(opt.ManSource),
+		Manual:  manVolumes[section],
+		Summary: summary,
+	}
+}
+
+// manSummary returns first sentence of markdown description without trailing period.
+func manSummary(description string) string {
+	for _, block := range parseMarkupBlocks(description) {
+		if block.Kind != markupParagraph {
+			continue
+		}
+
+		text := strings.Join(strings.Fields(block.Text), " ")
+		if end := strings.Index(text, ". "); end >= 0 {
+			text = text[:end]
+		}
+
+		return strings.TrimSuffix(text, ".")
+	}
+
+	return ""
+}
+
+// manTemplateFuncs provides utility functions available inside man page templates.
+//
+// View strings are markdown-escaped, so templates convert them with `markup`,
+// `inline` or `code`; raw strings such as title use `text` or `arg`.
+// Markup takes paragraph macro: `.PP` for sections, `.IP` inside `.TP` entries.
+func manTemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"jsonInline": func(value any) string {
+			return roffBold(roffText(mustJSONInline(value)))
+		},
+		"markup": func(paragraph, text string) string {
+			return markdownRoff(text, paragraph)
+		},
+		"inline": inlineRoff,
+		"code": func(value string) string {
+			return roffBold(roffText(markupPlainText("`" + value + "`")))
+		},
+		"text":    func(value string) string { return guardRoffLines(roffText(value)) },
+		"literal": func(value string) string { return roffBold(roffText(value)) },
+		"arg":     roffArg,
+		"heading": func(value string) string {
+			return roffArg(markupPlainText(value))
+		},
+		"listing": roffListing,
+		"list": func(values ...any) []any {
+			return values
+		},
+	}
+}
+
+// normalizeRoffOutput trims trailing spaces and drops blank lines, which roff renders as vertical space.
+func normalizeRoffOutput(text string) string {
+	lines := strings.Split(normalizeLineEndings(text), "\n")
+	out := make([]string, 0, len(lines))
+	for _, line := range lines {
+		line = strings.TrimRight(line, " \t")
+		if line != "" {
+			out = append(out, line)
+		}
+	}
+
+	return strings.Join(out, "\n")
+}
+
+// markdownRoff converts markdown description subset into roff blocks separated by paragraph macro.
+//
+// First paragraph has no macro, so it continues current section or `.TP` entry body.
+// Lists, code blocks and quotes are wrapped in `.RS`/`.RE`, so they keep
+// indentation of surrounding `.TP` entry.
+func markdownRoff(text, paragraph string) string {
+	blocks := parseMarkupBlocks(text)
+	out := make([]string, 0, len(blocks))
+	for index, block := range blocks {
+		switch block.Kind {
+		case markupBulletList, markupOrderedList:
+			items := make([]string, 0, len(block.Items)+2)
+			items = append(items, ".RS")
+			for number, item := range block.Items {
+				marker := `.IP \(bu 2`
+				if block.Kind == markupOrderedList {
+					marker = ".IP " + strconv.Itoa(number+1) + ". 4"
+				}
+
+				items = append(items, marker, inlineRoff(item))
+			}
+
+			out = append(out, strings.Join(append(items, ".RE"), "\n"))
+		case markupCodeBlock:
+			out = append(out, roffListing(block.Text))
+		case markupQuote:
+			out = append(out, ".RS\n"+inlineRoff(block.Text)+"\n.RE")
+		default:
+			if index > 0 {
+				out = append(out, paragraph)
+			}
+
+			out = append(out, inlineRoff(block.Text))
+		}
+	}
+
+	return strings.Join(out, "\n")
+}
+
+// roffListing renders verbatim code block in no-fill mode.
+func roffListing(code string) string {
+	lines := strings.Split(code, "\n")
+	for index, line := range lines {
+		lines[index] = `\&` + roffTextEscaper.Replace(line)
+	}
+
+	return ".RS 4\n.nf\n" + strings.Join(lines, "\n") + "\n.fi\n.RE"
+}
+
+// inlineRoff converts markdown inline text such as attribute value into roff text with font escapes.
+func inlineRoff(text string) string {
+	return guardRoffLines(roffSpans(parseMarkupInline(text)))
+}
+
+// roffSpans converts parsed inline spans into roff text; link labels keep their code and strong fonts.
+func roffSpans(spans []markupSpan) string {
+	var out strings.Builder
+	for _, span := range spans {
+		switch span.Kind {
+		case markupCode:
+			out.WriteString(roffBold(roffText(span.Text)))
+		case markupStrong:
+			out.WriteString(roffBold(roffText(plainSpans(span.Children))))
+		case markupLink:
+			label := plainSpans(span.Children)
+			out.WriteString(roffSpans(span.Children))
+
+			url, ok := safeMarkupURL(span.URL)
+			if ok && url != label && !strings.HasPrefix(url, "#") {
+				out.WriteString(" <" + roffText(url) + ">")
+			}
+		default:
+			out.WriteString(roffText(span.Text))
+		}
+	}
+
+	return out.String()
+}
+
+// roffText escapes backslashes and hyphens of raw text.
+func roffText(text string) string {
+	return roffTextEscaper.Replace(text)
+}
+
+// roffBold wraps escaped roff text in bold font escapes.
+func roffBold(text string) string {
+	return `\fB` + strings.ReplaceAll(text, "\n", " ") + `\fR`
+}
+
+// roffArg renders raw text as quoted macro argument.
+func roffArg(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	return `"` + strings.ReplaceAll(roffText(text), `"`, `\(dq`) + `"`
+}
+
+// guardRoffLines trims lines and protects ones starting with control characters from being read as requests.
+func guardRoffLines(text string) string {
+	lines := strings.Split(text, "\n")
+	for index, line := range lines {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, ".") || strings.HasPrefix(line, "'") {
+			line = `\&` + line
+		}
+
+		lines[index] = line
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"testing"
+)
+
+func TestRenderManPageEntries(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render(minimalSchemaBytes(t, map[string]any{
+		"$ref": "#/$defs/Config",
+		"$defs": map[string]any{
+			"Config": map[string]any{
+				"type":        "object",
+				"description": "Settings of the `demo` daemon. Loaded at start.\n\n- first\n- second\n\n```ini\n.dot = 1\n```",
+				"properties": map[string]any{
+					"port": map[string]any{
+						"type":        "integer",
+						"description": ".leading dot and back\\slash",
+						"default":     8080,
+						"minimum":     1,
+						"maximum":     65535,
+					},
+					"backend": map[string]any{"oneOf": []any{
+						map[string]any{"title": "Local", "type": "object", "properties": map[string]any{"kind": map[string]any{"const": "local"}}},
+						map[string]any{"title": "Remote", "type": "object", "properties": map[string]any{"kind": map[string]any{"const": "remote"}}},
+					}},
+				},
+			},
+		},
+	}), Options{Format: OutputFormatMan, Title: "demo.conf", ManDate: "2026-01-31", ManSource: "demo 1.0"})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, `.TH "DEMO.CONF" "5" "2026\-01\-31" "demo 1.0" "File Formats Manual"`)
+	assertContains(t, rendered, ".SH NAME\ndemo.conf \\- Settings of the \\fBdemo\\fR daemon\n.SH DESCRIPTION\n")
+	assertContains(t, rendered, ".RS\n.IP \\(bu 2\nfirst\n.IP \\(bu 2\nsecond\n.RE\n")
+	assertContains(t, rendered, ".nf\n\\&.dot = 1\n.fi\n")
+	assertContains(t, rendered, ".SH OPTIONS\n.SS \"Config\"\n")
+	assertContains(t, rendered, ".TP\n\\fBport\\fR\n\\&.leading dot and back\\eslash\n.IP\nType: \\fBinteger\\fR\n")
+	assertContains(t, rendered, "Default: \\fB8080\\fR\n.br\nConstraints: between 1 and 65535 (inclusive)")
+	assertContains(t, rendered, ".TP\n.I \"Config.backend oneOf 1: Local\"\n.IP\nApplies when \\fBkind\\fR = \\fBlocal\\fR.\n")
+	assertContains(t, rendered, ".TP\n\\fBbackend.kind\\fR\n")
+	assertNotContains(t, rendered, "\n\n")
+}
+
+func TestRenderManPageDefaultsHeader(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render(minimalSchemaBytes(t, map[string]any{
+		"type":       "object",
+		"properties": map[string]any{"name": map[string]any{"type": "string"}},
+	}), Options{TemplateName: "man", ManSection: "7", ExampleFormat: ExampleFormatJSON})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, `.TH "SCHEMA REFERENCE" "7" "" "" "Miscellaneous Information Manual"`)
+	assertContains(t, rendered, "schema reference \\- configuration file format\n")
+	assertContains(t, rendered, ".SH EXAMPLE\n.PP\nExample json document:\n.RS 4\n.nf\n\\&{\n")
+}
+
+func TestRoffEscaping(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "inline code", got: inlineRoff("use `--flag` or **bold `x`**"), want: `use \fB\-\-flag\fR or \fBbold x\fR`},
+		{name: "link", got: inlineRoff("[docs](https://example.com) and [Config](#config)"), want: `docs <https://example.com> and Config`},
+		{name: "control line", got: inlineRoff("a\n'quoted"), want: "a\n\\&'quoted"},
+		{name: "argument", got: roffArg(`say "hi"`), want: `"say \(dqhi\(dq"`},
+		{name: "summary", got: manSummary("First sentence. Second one.\n\nNext paragraph."), want: "First sentence"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.got != test.want {
+				t.Fatalf("got %q, want %q", test.got, test.want)
+			}
+		})
+	}
+}
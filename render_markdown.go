@@ -50,6 +50,18 @@ func normalizeWrapWidth(value int) int {
 	return value
 }
 
+// normalizeNestedDepth resolves nested property depth; zero uses default and negative disables nesting.
+func normalizeNestedDepth(value int) int {
+	switch {
+	case value == 0:
+		return defaultNestedDepth
+	case value < 0:
+		return 0
+	default:
+		return value
+	}
+}
+
 // normalizeListMarker validates list marker and falls back to default.
 func normalizeListMarker(value string) string {
 	switch strings.TrimSpace(value) {
@@ -372,6 +384,45 @@ func normalizeLineEndings(text string) string {
 
 // normalizeMarkdownOutput collapses extra blank lines outside fenced blocks.
 func normalizeMarkdownOutput(text string) string {
+	return collapseBlankLines(text, func(trimmed string) bool {
+		return strings.HasPrefix(trimmed, "```")
+	})
+}
+
+// isMarkdownFence reports whether trimmed line opens or closes fenced code block.
+func isMarkdownFence(trimmed string) bool {
+	return strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")
+}
+
+// shiftMarkdownHeadings moves ATX headings outside fenced blocks down by offset levels, capped at 6.
+func shiftMarkdownHeadings(text string, offset int) string {
+	if offset <= 0 {
+		return text
+	}
+
+	lines := strings.Split(text, "\n")
+	inFence := false
+	for index, line := range lines {
+		if isMarkdownFence(strings.TrimSpace(line)) {
+			inFence = !inFence
+			continue
+		}
+
+		level := len(line) - len(strings.TrimLeft(line, "#"))
+		if inFence || level == 0 || level > 6 || (level < len(line) && line[level] != ' ') {
+			continue
+		}
+
+		lines[index] = strings.Repeat("#", min(level+offset, 6)-level) + line
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// collapseBlankLines trims trailing spaces and collapses blank line runs outside delimited blocks.
+//
+// Delimiter reports whether trimmed line opens or closes verbatim block; nil disables block tracking.
+func collapseBlankLines(text string, delimiter func(trimmed string) bool) string {
 	text = normalizeLineEndings(text)
 	lines := strings.Split(text, "\n")
 	out := make([]string, 0, len(lines))
@@ -382,7 +433,7 @@ func normalizeMarkdownOutput(text string) string {
 		line := strings.TrimRight(rawLine, " \t")
 		trimmed := strings.TrimSpace(line)
 
-		if strings.HasPrefix(trimmed, "```") {
+		if delimiter != nil && delimiter(trimmed) {
 			inFence = !inFence
 			out = append(out, line)
 			blankCount = 0
@@ -410,6 +461,11 @@ func escapeInline(value string) string {
 	return strings.ReplaceAll(value, "`", "\\`")
 }
 
+// escapeHeading escapes backticks and `<` so synthetic keys like `<name>` are not read as HTML tags.
+func escapeHeading(value string) string {
+	return strings.ReplaceAll(escapeInline(value), "<", "\\<")
+}
+
 // ensureTrailingNewline guarantees exactly one trailing newline in output.
 func ensureTrailingNewline(value string) string {
 	value = strings.TrimRight(value, "\n")
@@ -0,0 +1,401 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// markupBlockKind identifies one block of markdown description subset.
+type markupBlockKind int
+
+const (
+	markupParagraph markupBlockKind = iota
+	markupBulletList
+	markupOrderedList
+	markupCodeBlock
+	markupQuote
+)
+
+// markupBlock is one parsed block of markdown text used by non-markdown backends.
+type markupBlock struct {
+	Text  string
+	Lang  string
+	Items []string
+	Kind  markupBlockKind
+}
+
+// markupSpanKind identifies one inline span of markdown text.
+type markupSpanKind int
+
+const (
+	markupText markupSpanKind = iota
+	markupCode
+	markupStrong
+	markupLink
+)
+
+// markupSpan is one parsed inline span; Text holds literal text without markdown escapes.
+type markupSpan struct {
+	Text     string
+	URL      string
+	Children []markupSpan
+	Kind     markupSpanKind
+}
+
+var (
+	markupListItemPattern = regexp.MustCompile(`^\s{0,3}([*+-]|\d{1,9}[.)])\s+(.*)$`)
+	markupFencePattern    = regexp.MustCompile("^\\s{0,3}(```+|~~~+)\\s*([A-Za-z0-9_+-]*)")
+)
+
+// parseMarkupBlocks splits markdown description into paragraphs, lists, fenced code and quotes.
+//
+// Nested lists are flattened; indented continuation lines join previous list item.
+func parseMarkupBlocks(text string) []markupBlock {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	out := make([]markupBlock, 0)
+
+	var current *markupBlock
+	flush := func() {
+		if current != nil {
+			out = append(out, *current)
+			current = nil
+		}
+	}
+
+	for index := 0; index < len(lines); index++ {
+		line := lines[index]
+		trimmed := strings.TrimSpace(line)
+
+		if match := markupFencePattern.FindStringSubmatch(line); match != nil {
+			flush()
+
+			fence := match[1]
+			code := make([]string, 0)
+			for index++; index < len(lines); index++ {
+				if strings.HasPrefix(strings.TrimSpace(lines[index]), fence) {
+					break
+				}
+
+				code = append(code, lines[index])
+			}
+
+			out = append(out, markupBlock{Kind: markupCodeBlock, Lang: match[2], Text: strings.Join(code, "\n")})
+			continue
+		}
+
+		if trimmed == "" {
+			flush()
+			continue
+		}
+
+		if match := markupListItemPattern.FindStringSubmatch(line); match != nil {
+			kind := markupBulletList
+			if !strings.ContainsAny(match[1], "*+-") {
+				kind = markupOrderedList
+			}
+
+			if current == nil || current.Kind != kind {
+				flush()
+				current = &markupBlock{Kind: kind}
+			}
+
+			current.Items = append(current.Items, match[2])
+			continue
+		}
+
+		if current != nil && (current.Kind == markupBulletList || current.Kind == markupOrderedList) && line != trimmed {
+			last := len(current.Items) - 1
+			current.Items[last] += "\n" + trimmed
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, ">") {
+			quoted := strings.TrimSpace(strings.TrimPrefix(trimmed, ">"))
+			if current == nil || current.Kind != markupQuote {
+				flush()
+				current = &markupBlock{Kind: markupQuote, Text: quoted}
+				continue
+			}
+
+			current.Text += "\n" + quoted
+			continue
+		}
+
+		if current == nil || current.Kind != markupParagraph {
+			flush()
+			current = &markupBlock{Kind: markupParagraph, Text: trimmed}
+			continue
+		}
+
+		current.Text += "\n" + trimmed
+	}
+
+	flush()
+	return out
+}
+
+// parseMarkupInline splits markdown inline text into literal text, code spans, strong spans and links.
+//
+// Backslash escapes of ASCII punctuation become literal characters; inside code spans
+// only escaped backticks are unescaped, matching escapeInline output.
+func parseMarkupInline(text string) []markupSpan {
+	out := make([]markupSpan, 0)
+
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() > 0 {
+			out = append(out, markupSpan{Kind: markupText, Text: literal.String()})
+			literal.Reset()
+		}
+	}
+
+	for index := 0; index < len(text); index++ {
+		char := text[index]
+		switch {
+		case char == '\\' && index+1 < len(text) && isASCIIPunctuation(text[index+1]):
+			index++
+			literal.WriteByte(text[index])
+		case char == '`':
+			code, end, ok := scanCodeSpan(text, index)
+			if !ok {
+				literal.WriteByte(char)
+				continue
+			}
+
+			flush()
+			out = append(out, markupSpan{Kind: markupCode, Text: code})
+			index = end
+		case char == '*' && strings.HasPrefix(text[index:], "**"):
+			end := strings.Index(text[index+2:], "**")
+			if end <= 0 {
+				literal.WriteString("**")
+				index++
+				continue
+			}
+
+			flush()
+			out = append(out, markupSpan{Kind: markupStrong, Children: parseMarkupInline(text[index+2 : index+2+end])})
+			index += end + 3
+		case char == '[':
+			label, url, end, ok := scanMarkupLink(text, index)
+			if !ok {
+				literal.WriteByte(char)
+				continue
+			}
+
+			flush()
+			out = append(out, markupSpan{Kind: markupLink, URL: url, Children: parseMarkupInline(label)})
+			index = end
+		case char == '<':
+			end := strings.IndexByte(text[index:], '>')
+			url := ""
+			if end > 0 {
+				url = text[index+1 : index+end]
+			}
+
+			if !isAutolink(url) {
+				literal.WriteByte(char)
+				continue
+			}
+
+			flush()
+			out = append(out, markupSpan{Kind: markupLink, URL: url, Children: []markupSpan{{Kind: markupText, Text: url}}})
+			index += end
+		default:
+			literal.WriteByte(char)
+		}
+	}
+
+	flush()
+	return out
+}
+
+// scanCodeSpan reads single-backtick code span starting at index and returns its unescaped content.
+func scanCodeSpan(text string, start int) (string, int, bool) {
+	var code strings.Builder
+	for index := start + 1; index < len(text); index++ {
+		switch {
+		case text[index] == '\\' && index+1 < len(text) && text[index+1] == '`':
+			code.WriteByte('`')
+			index++
+		case text[index] == '`':
+			return code.String(), index, true
+		default:
+			code.WriteByte(text[index])
+		}
+	}
+
+	return "", 0, false
+}
+
+// scanMarkupLink reads `[label](url)` link starting at index.
+func scanMarkupLink(text string, start int) (string, string, int, bool) {
+	depth := 0
+	for index := start; index < len(text); index++ {
+		switch text[index] {
+		case '\\':
+			index++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth > 0 {
+				continue
+			}
+
+			if index+1 >= len(text) || text[index+1] != '(' {
+				return "", "", 0, false
+			}
+
+			end := closingParen(text[index+2:])
+			if end < 0 {
+				return "", "", 0, false
+			}
+
+			url := strings.TrimSpace(text[index+2 : index+2+end])
+			if url == "" || strings.ContainsAny(url, " \n") {
+				return "", "", 0, false
+			}
+
+			return text[start+1 : index], url, index + 2 + end, true
+		}
+	}
+
+	return "", "", 0, false
+}
+
+// closingParen returns index of parenthesis closing link destination, honoring balanced pairs.
+func closingParen(text string) int {
+	depth := 0
+	for index := 0; index < len(text); index++ {
+		switch text[index] {
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				return index
+			}
+
+			depth--
+		}
+	}
+
+	return -1
+}
+
+// isAutolink reports whether angle bracket content is absolute URL or email autolink.
+func isAutolink(value string) bool {
+	if value == "" || strings.ContainsAny(value, " <>\n") {
+		return false
+	}
+
+	if strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://") || strings.HasPrefix(value, "mailto:") {
+		return true
+	}
+
+	return false
+}
+
+// isASCIIPunctuation reports whether byte is ASCII punctuation escapable in markdown.
+func isASCIIPunctuation(char byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", char) >= 0
+}
+
+// safeMarkupURL returns link target unless it uses scheme other than http, https or mailto.
+func safeMarkupURL(url string) (string, bool) {
+	lower := strings.ToLower(strings.TrimSpace(url))
+	colon := strings.IndexByte(lower, ':')
+	if colon < 0 || strings.ContainsAny(lower[:colon], "/?#") {
+		return url, true
+	}
+
+	switch lower[:colon] {
+	case "http", "https", "mailto":
+		return url, true
+	default:
+		return "", false
+	}
+}
+
+// markupPlainText returns literal text of inline markdown without markup.
+func markupPlainText(text string) string {
+	return plainSpans(parseMarkupInline(text))
+}
+
+// plainSpans returns literal text of parsed inline spans.
+func plainSpans(spans []markupSpan) string {
+	var out strings.Builder
+	writeMarkupPlainText(&out, spans)
+	return out.String()
+}
+
+// writeMarkupPlainText writes literal text of spans.
+func writeMarkupPlainText(out *strings.Builder, spans []markupSpan) {
+	for _, span := range spans {
+		switch span.Kind {
+		case markupStrong, markupLink:
+			writeMarkupPlainText(out, span.Children)
+		default:
+			out.WriteString(span.Text)
+		}
+	}
+}
+
+// markupPiece is one rendered inline fragment of AsciiDoc or reStructuredText output.
+//
+// Markup pieces must be delimited from adjacent text: Tight is used instead of Text
+// when set, otherwise separator is inserted between Text and its neighbours.
+type markupPiece struct {
+	Text   string
+	Tight  string
+	Markup bool
+}
+
+// joinMarkupPieces joins rendered inline fragments handling markup boundaries.
+func joinMarkupPieces(pieces []markupPiece, separator string) string {
+	var out strings.Builder
+	for index, piece := range pieces {
+		if !piece.Markup {
+			out.WriteString(piece.Text)
+			continue
+		}
+
+		before := touchesMarkupBefore(out.String())
+		after := index+1 < len(pieces) && touchesMarkupAfter(pieces[index+1].Text)
+		if (before || after) && piece.Tight != "" {
+			out.WriteString(piece.Tight)
+			continue
+		}
+
+		if before {
+			out.WriteString(separator)
+		}
+
+		out.WriteString(piece.Text)
+		if after {
+			out.WriteString(separator)
+		}
+	}
+
+	return out.String()
+}
+
+// touchesMarkupBefore reports whether text preceding inline markup ends with character
+// that prevents markup start, anything except whitespace and opening punctuation.
+func touchesMarkupBefore(text string) bool {
+	char, size := utf8.DecodeLastRuneInString(text)
+	return size > 0 && !unicode.IsSpace(char) && !strings.ContainsRune("([{<-/", char)
+}
+
+// touchesMarkupAfter reports whether text following inline markup starts with character
+// that prevents markup end, anything except whitespace and closing punctuation.
+func touchesMarkupAfter(text string) bool {
+	char, size := utf8.DecodeRuneInString(text)
+	return size > 0 && !unicode.IsSpace(char) && !strings.ContainsRune(".,:;!?)]}>-/'\"", char)
+}
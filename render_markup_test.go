@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMarkupBlocks(t *testing.T) {
+	t.Parallel()
+
+	blocks := parseMarkupBlocks("First line\nsecond line.\n\n* one\n  continued\n* two\n\n1. step\n\n```yaml\nkey: value\n```\n\n> quoted\n> text")
+	want := []markupBlock{
+		{Kind: markupParagraph, Text: "First line\nsecond line."},
+		{Kind: markupBulletList, Items: []string{"one\ncontinued", "two"}},
+		{Kind: markupOrderedList, Items: []string{"step"}},
+		{Kind: markupCodeBlock, Lang: "yaml", Text: "key: value"},
+		{Kind: markupQuote, Text: "quoted\ntext"},
+	}
+
+	if !reflect.DeepEqual(blocks, want) {
+		t.Fatalf("parseMarkupBlocks() = %#v, want %#v", blocks, want)
+	}
+}
+
+func TestParseMarkupInline(t *testing.T) {
+	t.Parallel()
+
+	spans := parseMarkupInline("Use `a\\`b` with **bold** \\<name> and [docs](https://example.com/a_(b)) or <https://example.com>.")
+	want := []markupSpan{
+		{Kind: markupText, Text: "Use "},
+		{Kind: markupCode, Text: "a`b"},
+		{Kind: markupText, Text: " with "},
+		{Kind: markupStrong, Children: []markupSpan{{Kind: markupText, Text: "bold"}}},
+		{Kind: markupText, Text: " <name> and "},
+		{Kind: markupLink, URL: "https://example.com/a_(b)", Children: []markupSpan{{Kind: markupText, Text: "docs"}}},
+		{Kind: markupText, Text: " or "},
+		{Kind: markupLink, URL: "https://example.com", Children: []markupSpan{{Kind: markupText, Text: "https://example.com"}}},
+		{Kind: markupText, Text: "."},
+	}
+
+	if !reflect.DeepEqual(spans, want) {
+		t.Fatalf("parseMarkupInline() = %#v, want %#v", spans, want)
+	}
+}
+
+// markupFormatSchema returns schema with markdown descriptions and special characters for backend tests.
+func markupFormatSchema(t *testing.T) []byte {
+	t.Helper()
+
+	return minimalSchemaBytes(t, map[string]any{
+		"$ref": "#/$defs/Config",
+		"$defs": map[string]any{
+			"Config": map[string]any{
+				"type":        "object",
+				"description": "Server `a|b` and **limits**, see [docs](https://example.com/docs) or [Config](#config).\n\n- first_item\n- not [this](javascript:alert(1))\n\n```yaml\nkey: value\n```",
+				"properties": map[string]any{
+					"name": map[string]any{"type": "string", "pattern": "^a`b|c$"},
+					"backends": map[string]any{
+						"type":                 "object",
+						"additionalProperties": map[string]any{"type": "string"},
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,222 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+const (
+	// defaultPageName is file name pattern of definition pages when caller does not set one.
+	defaultPageName = "{slug}.md"
+	// defaultIndexPage is file name of index page when caller does not set one.
+	defaultIndexPage = "index.md"
+	// pageSlugPlaceholder is replaced with heading anchor of definition name.
+	pageSlugPlaceholder = "{slug}"
+	// pageNamePlaceholder is replaced with definition name restricted to file name characters.
+	pageNamePlaceholder = "{name}"
+)
+
+// Page is one file of multi-page markdown output.
+type Page struct {
+	// Path is file name relative to output directory.
+	Path string `json:"path"`
+
+	// Definition is definition documented on page, empty for index page.
+	Definition string `json:"definition,omitempty"`
+
+	// Content is rendered markdown document.
+	Content string `json:"content"`
+}
+
+// RenderPagesFile reads schema from file and renders multi-page markdown documentation.
+func RenderPagesFile(path string, opt Options) ([]Page, error) {
+	schemaBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrReadSchemaFile, err)
+	}
+
+	if strings.TrimSpace(opt.SourcePath) == "" {
+		opt.SourcePath = path
+	}
+
+	if strings.TrimSpace(opt.BaseDir) == "" {
+		opt.BaseDir = filepath.Dir(path)
+	}
+
+	return RenderPages(schemaBytes, opt)
+}
+
+// RenderPages converts schema bytes into index page and one markdown page per definition.
+//
+// Index page comes first and holds document header, contents and embedded example.
+// Definition pages follow in rendering order; `$ref` attribute values, variant
+// references and property paths link to pages and anchors where targets are documented.
+func RenderPages(schemaBytes []byte, opt Options) ([]Page, error) {
+	format, err := resolveOutputFormat(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if format != OutputFormatMarkdown {
+		return nil, fmt.Errorf("%w: %s", ErrPagesFormat, format)
+	}
+
+	view, err := loadRenderView(schemaBytes, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	markdownTemplate, err := resolveTemplate(opt, format)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(view.Definitions))
+	for _, definition := range view.Definitions {
+		names = append(names, definition.Name)
+	}
+
+	indexPage, files, err := pageFileNames(opt, names)
+	if err != nil {
+		return nil, err
+	}
+
+	linker := newPageLinker(view.Definitions, files)
+	pages := make([]Page, 0, len(view.Definitions)+1)
+
+	index := view
+	index.Definitions = nil
+	index.Index = linker.forPage(indexPage).linkIndex(view.Index)
+	index.Contents = make([]contentsEntry, 0, len(view.Definitions))
+	for _, definition := range view.Definitions {
+		index.Contents = append(index.Contents, contentsEntry{Name: definition.Name, Href: files[definition.Name]})
+	}
+
+	content, err := executeMarkdownTemplate(markdownTemplate, index, linker.forPage(indexPage), opt.HeadingOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	pages = append(pages, Page{Path: indexPage, Content: content})
+	for _, definition := range view.Definitions {
+		file := files[definition.Name]
+		pageLinks := linker.forPage(file)
+
+		page := view
+		page.IndexLink = indexPage
+		page.Contents = nil
+		page.Index = nil
+		page.ExampleFormat = ""
+		page.ExampleDocument = ""
+		page.Definitions = []definitionView{pageLinks.linkDefinition(definition)}
+
+		content, err := executeMarkdownTemplate(markdownTemplate, page, pageLinks, opt.HeadingOffset)
+		if err != nil {
+			return nil, err
+		}
+
+		pages = append(pages, Page{Path: file, Definition: markupPlainText(definition.Name), Content: content})
+	}
+
+	return pages, nil
+}
+
+// executeMarkdownTemplate executes markdown template with link helpers of linker and shifts heading levels.
+func executeMarkdownTemplate(markdownTemplate *template.Template, view renderView, linker definitionLinker, headingOffset int) (string, error) {
+	markdownTemplate.Funcs(linker.templateFuncs())
+	markdownTemplate.Funcs(template.FuncMap{
+		"listMarker": func() string {
+			return view.ListMarker
+		},
+	})
+
+	var out strings.Builder
+	if err := markdownTemplate.Execute(&out, view); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrExecuteMarkdownTemplate, err)
+	}
+
+	return ensureTrailingNewline(shiftMarkdownHeadings(normalizeMarkdownOutput(out.String()), headingOffset)), nil
+}
+
+// pageFileNames returns index page name and page file names keyed by escaped definition name.
+//
+// Names follow definition order, so colliding slugs get `-2`, `-3` suffixes deterministically.
+// Names are compared case-insensitively to stay distinct on case-insensitive file systems.
+func pageFileNames(opt Options, names []string) (string, map[string]string, error) {
+	pattern := strings.TrimSpace(opt.PageName)
+	if pattern == "" {
+		pattern = defaultPageName
+	}
+
+	indexPage := strings.TrimSpace(opt.IndexPage)
+	if indexPage == "" {
+		indexPage = defaultIndexPage
+	}
+
+	if !strings.Contains(pattern, pageSlugPlaceholder) && !strings.Contains(pattern, pageNamePlaceholder) {
+		return "", nil, fmt.Errorf("%w %q: pattern must contain %s or %s", ErrInvalidPageName, pattern, pageSlugPlaceholder, pageNamePlaceholder)
+	}
+
+	for _, value := range []string{pattern, indexPage} {
+		if strings.ContainsAny(value, `/\`) || strings.Trim(value, ".") == "" {
+			return "", nil, fmt.Errorf("%w %q: must be file name without directories", ErrInvalidPageName, value)
+		}
+	}
+
+	ext := path.Ext(pattern)
+	if strings.ContainsAny(ext, "{}") {
+		ext = ""
+	}
+
+	taken := map[string]struct{}{strings.ToLower(indexPage): {}}
+	files := make(map[string]string, len(names))
+	for _, name := range names {
+		slug := markdownHeadingAnchor(name)
+		if slug == "" {
+			slug = "definition"
+		}
+
+		file := strings.NewReplacer(pageSlugPlaceholder, slug, pageNamePlaceholder, pageFileSafeName(markupPlainText(name))).Replace(pattern)
+		stem := strings.TrimSuffix(file, ext)
+		for index := 2; ; index++ {
+			if _, ok := taken[strings.ToLower(file)]; !ok {
+				break
+			}
+
+			file = stem + "-" + strconv.Itoa(index) + ext
+		}
+
+		taken[strings.ToLower(file)] = struct{}{}
+		files[name] = file
+	}
+
+	return indexPage, files, nil
+}
+
+// pageFileSafeName replaces characters outside letters, digits, `-`, `_` and `.` with `_`.
+func pageFileSafeName(name string) string {
+	safe := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' || r == '.' {
+			return r
+		}
+
+		return '_'
+	}, name)
+
+	safe = strings.TrimLeft(safe, ".")
+	if safe == "" {
+		return "definition"
+	}
+
+	return safe
+}
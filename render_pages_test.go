@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRenderPagesLinksDefinitionPages(t *testing.T) {
+	t.Parallel()
+
+	pages, err := RenderPages(minimalSchemaBytes(t, map[string]any{
+		"$ref": "#/$defs/Config",
+		"$defs": map[string]any{
+			"Config": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"server": map[string]any{"$ref": "#/$defs/Server"},
+					"backend": map[string]any{"oneOf": []any{
+						map[string]any{"$ref": "#/$defs/Server"},
+						map[string]any{"type": "string"},
+					}},
+				},
+			},
+			"Server": map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"port": map[string]any{"type": "integer"}},
+			},
+		},
+	}), Options{Title: "Demo", ExampleFormat: ExampleFormatJSON})
+	if err != nil {
+		t.Fatalf("RenderPages: %v", err)
+	}
+
+	if len(pages) != 3 {
+		t.Fatalf("pages = %d, want 3", len(pages))
+	}
+
+	index, config, server := pages[0], pages[1], pages[2]
+	if index.Path != "index.md" || config.Path != "config.md" || server.Path != "server.md" {
+		t.Fatalf("page paths = %q, %q, %q", index.Path, config.Path, server.Path)
+	}
+
+	if config.Definition != "Config" || index.Definition != "" {
+		t.Fatalf("page definitions = %q, %q", index.Definition, config.Definition)
+	}
+
+	assertContains(t, index.Content, "* [Config](config.md)\n* [Server](server.md)\n")
+	assertContains(t, index.Content, "## Example json document")
+	assertNotContains(t, index.Content, "## Config")
+
+	assertContains(t, config.Content, "# Demo\n\n[Contents](index.md)\n\n## Config\n")
+	assertContains(t, config.Content, "* Reference: [`#/$defs/Server`](server.md)")
+	assertContains(t, config.Content, "See [Server](server.md).")
+	assertNotContains(t, config.Content, "Schema ID")
+	assertNotContains(t, config.Content, "Example json document")
+
+	assertContains(t, server.Content, "* [`backend.port`](config.md#configbackend)\n* [`server.port`](config.md#configserver)\n")
+	assertContains(t, server.Content, "Referenced by:\n\n* [Config](config.md): `backend`, `server`\n")
+	assertNotContains(t, server.Content, "## Config")
+}
+
+func TestRenderPagesResolvesSlugCollisions(t *testing.T) {
+	t.Parallel()
+
+	schema := minimalSchemaBytes(t, map[string]any{
+		"$ref": "#/$defs/Index",
+		"$defs": map[string]any{
+			"Index":    map[string]any{"type": "object"},
+			"foo-bar":  map[string]any{"type": "object"},
+			"Foo_Bar":  map[string]any{"type": "object"},
+			"foo bar!": map[string]any{"type": "object"},
+		},
+	})
+
+	pages, err := RenderPages(schema, Options{})
+	if err != nil {
+		t.Fatalf("RenderPages: %v", err)
+	}
+
+	got := make([]string, 0, len(pages))
+	for _, page := range pages {
+		got = append(got, page.Path)
+	}
+
+	want := []string{"index.md", "index-2.md", "foo-bar.md", "foo-bar-2.md", "foo-bar-3.md"}
+	if len(got) != len(want) {
+		t.Fatalf("page paths = %q, want %q", got, want)
+	}
+
+	for index := range want {
+		if got[index] != want[index] {
+			t.Fatalf("page paths = %q, want %q", got, want)
+		}
+	}
+
+	pages, err = RenderPages(schema, Options{PageName: "ref-{name}.md", IndexPage: "README.md"})
+	if err != nil {
+		t.Fatalf("RenderPages with page name: %v", err)
+	}
+
+	if pages[0].Path != "README.md" || pages[1].Path != "ref-Index.md" || pages[3].Path != "ref-foo_bar_.md" {
+		t.Fatalf("page paths = %q, %q, %q", pages[0].Path, pages[1].Path, pages[3].Path)
+	}
+}
+
+func TestRenderPagesRejectsInvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	schema := minimalSchemaBytes(t, map[string]any{"type": "object"})
+
+	for _, opt := range []Options{
+		{PageName: "page.md"},
+		{PageName: "defs/{slug}.md"},
+		{IndexPage: "../index.md"},
+	} {
+		if _, err := RenderPages(schema, opt); !errors.Is(err, ErrInvalidPageName) {
+			t.Fatalf("RenderPages(%+v) error = %v, want ErrInvalidPageName", opt, err)
+		}
+	}
+
+	if _, err := RenderPages(schema, Options{Format: OutputFormatHTML}); !errors.Is(err, ErrPagesFormat) {
+		t.Fatalf("RenderPages html error = %v, want ErrPagesFormat", err)
+	}
+}
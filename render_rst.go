@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"regexp"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+)
+
+// rstBlockStartPattern matches line starts that reStructuredText could read as list, directive,
+// field, quote or section adornment instead of paragraph text.
+var rstBlockStartPattern = regexp.MustCompile(`^([-+#.:>=~^'"(|]|\d|\w{1,9}[.)](\s|$))`)
+
+// rstTemplateFuncs provides utility functions available inside reStructuredText templates.
+//
+// View strings are markdown-escaped, so templates convert them with `markup`,
+// `inline` or `code`; raw strings such as title use `text` or `literal`.
+func rstTemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"jsonInline": func(value any) string {
+			return rstLiteral(mustJSONInline(value))
+		},
+		"headingAnchor": markdownHeadingAnchor,
+		"markup":        markdownRST,
+		"inline":        inlineRST,
+		"code": func(value string) string {
+			return rstLiteral(markupPlainText("`" + value + "`"))
+		},
+		"label": func(value string) string {
+			return rstLinkLabel(markupPlainText(value))
+		},
+		"text":    rstText,
+		"literal": rstLiteral,
+		"heading": rstHeading,
+		"title":   rstTitle,
+		"indent":  rstIndent,
+		"listing": rstListing,
+	}
+}
+
+// markdownRST converts markdown description subset into reStructuredText blocks.
+func markdownRST(text string) string {
+	blocks := parseMarkupBlocks(text)
+	out := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		switch block.Kind {
+		case markupBulletList, markupOrderedList:
+			marker := "* "
+			if block.Kind == markupOrderedList {
+				marker = "#. "
+			}
+
+			items := make([]string, 0, len(block.Items))
+			for _, item := range block.Items {
+				items = append(items, marker+rstIndent(len(marker), guardRSTLines(inlineRST(item))))
+			}
+
+			out = append(out, strings.Join(items, "\n"))
+		case markupCodeBlock:
+			out = append(out, rstListing(block.Lang, block.Text))
+		case markupQuote:
+			out = append(out, "   "+rstIndent(3, guardRSTLines(inlineRST(block.Text))))
+		default:
+			out = append(out, guardRSTLines(inlineRST(block.Text)))
+		}
+	}
+
+	return strings.Join(out, "\n\n")
+}
+
+// rstListing renders verbatim code block, with code-block directive when language is known.
+func rstListing(lang, code string) string {
+	head := "::"
+	if lang != "" {
+		head = ".. code-block:: " + lang
+	}
+
+	return head + "\n\n   " + rstIndent(3, code)
+}
+
+// guardRSTLines escapes first character of lines that would otherwise start block syntax.
+func guardRSTLines(text string) string {
+	lines := strings.Split(text, "\n")
+	for index, line := range lines {
+		if rstBlockStartPattern.MatchString(line) {
+			lines[index] = "\\" + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// inlineRST converts markdown inline text such as attribute value into reStructuredText.
+func inlineRST(text string) string {
+	return joinMarkupPieces(rstPieces(parseMarkupInline(text)), "\\ ")
+}
+
+// rstPieces renders inline spans as reStructuredText fragments.
+//
+// Nested markup is not supported by reStructuredText, so strong and link labels are flattened.
+func rstPieces(spans []markupSpan) []markupPiece {
+	out := make([]markupPiece, 0, len(spans))
+	for _, span := range spans {
+		switch span.Kind {
+		case markupCode:
+			out = append(out, markupPiece{Text: rstLiteral(span.Text), Markup: true})
+		case markupStrong:
+			out = append(out, markupPiece{Text: "**" + rstText(plainSpans(span.Children)) + "**", Markup: true})
+		case markupLink:
+			out = append(out, rstLink(span))
+		default:
+			out = append(out, markupPiece{Text: rstText(span.Text)})
+		}
+	}
+
+	return out
+}
+
+// rstLink renders markdown link as anonymous hyperlink; `#anchor` targets refer to internal targets.
+func rstLink(span markupSpan) markupPiece {
+	label := rstLinkLabel(plainSpans(span.Children))
+	if anchor, ok := strings.CutPrefix(span.URL, "#"); ok {
+		return markupPiece{Text: "`" + label + " <" + anchor + "_>`__", Markup: true}
+	}
+
+	url, ok := safeMarkupURL(span.URL)
+	if !ok {
+		return markupPiece{Text: label}
+	}
+
+	return markupPiece{Text: "`" + label + " <" + url + ">`__", Markup: true}
+}
+
+// rstLinkLabel escapes raw text for hyperlink label, where angle brackets would start embedded target.
+func rstLinkLabel(text string) string {
+	return strings.NewReplacer("<", "\\<", ">", "\\>").Replace(rstText(text))
+}
+
+// rstText escapes characters that start reStructuredText inline markup or references.
+func rstText(text string) string {
+	var out strings.Builder
+	out.Grow(len(text))
+	for _, char := range text {
+		if strings.ContainsRune("\\*`_|", char) {
+			out.WriteByte('\\')
+		}
+
+		out.WriteRune(char)
+	}
+
+	return out.String()
+}
+
+// rstLiteral renders raw text as inline literal; values inline literal cannot hold fall back to escaped text.
+func rstLiteral(text string) string {
+	text = strings.ReplaceAll(text, "\n", " ")
+	if strings.TrimSpace(text) != text || text == "" || strings.Contains(text, "``") || strings.HasSuffix(text, "`") {
+		return rstText(text)
+	}
+
+	return "``" + text + "``"
+}
+
+// rstHeading renders section title underlined with adornment character to title width.
+func rstHeading(adornment, text string) string {
+	return text + "\n" + strings.Repeat(adornment, max(utf8.RuneCountInString(text), 1))
+}
+
+// rstTitle renders document title with `=` overline and underline.
+func rstTitle(text string) string {
+	line := strings.Repeat("=", max(utf8.RuneCountInString(text), 1))
+	return line + "\n" + text + "\n" + line
+}
+
+// rstIndent indents every line of text except first by width spaces, for list items and directive content.
+func rstIndent(width int, text string) string {
+	padding := strings.Repeat(" ", width)
+	lines := strings.Split(text, "\n")
+	for index := 1; index < len(lines); index++ {
+		if lines[index] != "" {
+			lines[index] = padding + lines[index]
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
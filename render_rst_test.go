@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"testing"
+)
+
+func TestRenderRSTConvertsDescriptionsAndEscapes(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render(markupFormatSchema(t), Options{Format: OutputFormatRST, Title: "Config <Reference>"})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "==================\nConfig <Reference>\n==================\n")
+	assertContains(t, rendered, "* `Config <config_>`__")
+	assertContains(t, rendered, ".. _config:\n\nConfig\n======\n")
+	assertContains(t, rendered, "Server ``a|b`` and **limits**, see `docs <https://example.com/docs>`__ or\n`Config <config_>`__.")
+	assertContains(t, rendered, "* first\\_item\n* not this\n")
+	assertContains(t, rendered, ".. code-block:: yaml\n\n   key: value\n")
+	assertContains(t, rendered, ".. list-table::\n   :header-rows: 1\n   :widths: 25 75\n\n   * - Attribute\n     - Value\n")
+	assertContains(t, rendered, "   * - Constraints\n     - must match ``^a`b|c$``\n")
+	assertContains(t, rendered, ".. _configbackendsname:\n\nConfig.backends.<name>\n----------------------\n")
+	assertNotContains(t, rendered, "javascript:")
+}
+
+func TestInlineRST(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "plain", in: "plain text", want: "plain text"},
+		{name: "special", in: "a_b *c* |d|", want: "a\\_b \\*c\\* \\|d\\|"},
+		{name: "code", in: "use `x` here", want: "use ``x`` here"},
+		{name: "code in word", in: "`x`s", want: "``x``\\ s"},
+		{name: "code after punctuation", in: "a.`x`", want: "a.\\ ``x``"},
+		{name: "strong", in: "**bold `x`**", want: "**bold x**"},
+		{name: "anchor", in: "[Config](#config)", want: "`Config <config_>`__"},
+		{name: "label brackets", in: "[a <b>](https://example.com)", want: "`a \\<b\\> <https://example.com>`__"},
+		{name: "unsafe link", in: "[x](javascript:void(0))", want: "x"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := inlineRST(test.in); got != test.want {
+				t.Fatalf("inlineRST(%q) = %q, want %q", test.in, got, test.want)
+			}
+		})
+	}
+}
+
+func TestMarkdownRSTBlocks(t *testing.T) {
+	t.Parallel()
+
+	got := markdownRST("- first\n  continued\n\n1. one\n\n> quoted\n\n- Alpha: beta\n\nA. Einstein\n\n```\nraw\n```")
+	want := "* first\n  continued\n\n#. one\n\n   quoted\n\n* Alpha: beta\n\n\\A. Einstein\n\n::\n\n   raw"
+	if got != want {
+		t.Fatalf("markdownRST = %q, want %q", got, want)
+	}
+}
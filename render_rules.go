@@ -0,0 +1,371 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// schemaRules describes if/then/else, dependentRequired, dependentSchemas
+// and legacy dependencies of schema node as prose sentences.
+//
+// Conditionals of inline allOf items are included, so stacked if/then pairs
+// are explained one sentence per pair.
+func schemaRules(node schemaValue, order keyOrder) []string {
+	if node.Object == nil {
+		return nil
+	}
+
+	out := make([]string, 0)
+	if rule := conditionalRule(node.Object, order); rule != "" {
+		out = append(out, rule)
+	}
+
+	for _, raw := range asSlice(node.Object["allOf"]) {
+		item, ok := raw.(map[string]any)
+		if !ok || schemaReference(item) != "" {
+			continue
+		}
+
+		if rule := conditionalRule(item, order); rule != "" {
+			out = append(out, rule)
+		}
+	}
+
+	out = append(out, dependentRequiredRules(node.Object["dependentRequired"], order)...)
+	out = append(out, dependentSchemaRules(node.Object["dependentSchemas"], order)...)
+	out = append(out, dependentRequiredRules(node.Object["dependencies"], order)...)
+	out = append(out, dependentSchemaRules(node.Object["dependencies"], order)...)
+
+	return out
+}
+
+// conditionalRule explains if/then/else of schema object; empty result means no then/else branch.
+func conditionalRule(node map[string]any, order keyOrder) string {
+	ifSchema, ok := node["if"]
+	if !ok {
+		return ""
+	}
+
+	thenSchema, hasThen := node["then"]
+	elseSchema, hasElse := node["else"]
+	if !hasThen && !hasElse {
+		return ""
+	}
+
+	condition := conditionText(ifSchema, order)
+	if condition == "" {
+		condition = "the `if` schema matches"
+	}
+
+	var out strings.Builder
+	if hasThen {
+		effect := effectText(thenSchema, order)
+		if effect == "" {
+			effect = "the `then` schema applies"
+		}
+
+		fmt.Fprintf(&out, "When %s, %s", condition, effect)
+	}
+
+	if hasElse {
+		effect := effectText(elseSchema, order)
+		if effect == "" {
+			effect = "the `else` schema applies"
+		}
+
+		if hasThen {
+			fmt.Fprintf(&out, "; otherwise, %s", effect)
+		} else {
+			fmt.Fprintf(&out, "Unless %s, %s", condition, effect)
+		}
+	}
+
+	out.WriteByte('.')
+	return out.String()
+}
+
+// dependentRequiredRules explains property name lists of dependentRequired or dependencies.
+func dependentRequiredRules(value any, order keyOrder) []string {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	out := make([]string, 0, len(object))
+	for _, key := range order.keys(object) {
+		names, ok := object[key].([]any)
+		if !ok {
+			continue
+		}
+
+		required := requiredText(asStringSlice(names))
+		if required == "" {
+			continue
+		}
+
+		out = append(out, fmt.Sprintf("When %s, %s.", presentText(key), required))
+	}
+
+	return out
+}
+
+// dependentSchemaRules explains schema values of dependentSchemas or dependencies.
+func dependentSchemaRules(value any, order keyOrder) []string {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	out := make([]string, 0, len(object))
+	for _, key := range order.keys(object) {
+		schema := object[key]
+		switch schema.(type) {
+		case map[string]any, bool:
+		default:
+			continue
+		}
+
+		effect := effectText(schema, order)
+		if effect == "" {
+			effect = "the dependent schema applies"
+		}
+
+		out = append(out, fmt.Sprintf("When %s, %s.", presentText(key), effect))
+	}
+
+	return out
+}
+
+// conditionText describes if schema built from required names and const/enum properties.
+//
+// Empty result means schema uses keywords that have no prose form.
+func conditionText(value any, order keyOrder) string {
+	node, ok := value.(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	for key := range node {
+		switch key {
+		case "properties", "required", "type", "title", "description", "$comment":
+		default:
+			return ""
+		}
+	}
+
+	parts := make([]string, 0)
+	described := make(map[string]struct{})
+	properties, _ := node["properties"].(map[string]any)
+	for _, name := range order.keys(properties) {
+		text := valueConditionText(name, properties[name])
+		if text == "" {
+			return ""
+		}
+
+		described[name] = struct{}{}
+		parts = append(parts, text)
+	}
+
+	for _, name := range asStringSlice(node["required"]) {
+		if _, ok := described[name]; ok {
+			continue
+		}
+
+		parts = append(parts, presentText(name))
+	}
+
+	return joinProse(parts, "and")
+}
+
+// valueConditionText describes one if property matched by const or enum.
+func valueConditionText(name string, value any) string {
+	property, ok := value.(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	for key := range property {
+		switch key {
+		case "const", "enum", "type", "title", "description", "$comment":
+		default:
+			return ""
+		}
+	}
+
+	if constant, ok := property["const"]; ok {
+		return fmt.Sprintf("`%s` is %s", escapeInline(name), proseValue(constant))
+	}
+
+	enum := asSlice(property["enum"])
+	switch len(enum) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("`%s` is %s", escapeInline(name), proseValue(enum[0]))
+	default:
+		return fmt.Sprintf("`%s` is one of %s", escapeInline(name), proseValues(enum))
+	}
+}
+
+// effectText describes then/else or dependent schema built from required names,
+// forbidden properties and const/enum property values.
+//
+// Empty result means schema uses keywords that have no prose form.
+func effectText(value any, order keyOrder) string {
+	if allowed, ok := value.(bool); ok {
+		if allowed {
+			return ""
+		}
+
+		return "no value is allowed"
+	}
+
+	node, ok := value.(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	for key := range node {
+		switch key {
+		case "properties", "required", "not", "title", "description", "$comment":
+		default:
+			return ""
+		}
+	}
+
+	parts := make([]string, 0)
+	if required := requiredText(asStringSlice(node["required"])); required != "" {
+		parts = append(parts, required)
+	}
+
+	if not, ok := node["not"]; ok {
+		notObject, ok := not.(map[string]any)
+		if !ok || len(notObject) != 1 {
+			return ""
+		}
+
+		names := asStringSlice(notObject["required"])
+		if len(names) == 0 {
+			return ""
+		}
+
+		parts = append(parts, forbiddenText(names))
+	}
+
+	properties, _ := node["properties"].(map[string]any)
+	for _, name := range order.keys(properties) {
+		text := valueEffectText(name, properties[name])
+		if text == "" {
+			return ""
+		}
+
+		parts = append(parts, text)
+	}
+
+	return joinProse(parts, "and")
+}
+
+// valueEffectText describes one then/else property restricted by const, enum or false schema.
+func valueEffectText(name string, value any) string {
+	if allowed, ok := value.(bool); ok {
+		if allowed {
+			return ""
+		}
+
+		return forbiddenText([]string{name})
+	}
+
+	property, ok := value.(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	for key := range property {
+		switch key {
+		case "const", "enum", "title", "description", "$comment":
+		default:
+			return ""
+		}
+	}
+
+	if constant, ok := property["const"]; ok {
+		return fmt.Sprintf("`%s` must be %s", escapeInline(name), proseValue(constant))
+	}
+
+	enum := asSlice(property["enum"])
+	switch len(enum) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("`%s` must be %s", escapeInline(name), proseValue(enum[0]))
+	default:
+		return fmt.Sprintf("`%s` must be one of %s", escapeInline(name), proseValues(enum))
+	}
+}
+
+// requiredText renders "`a` is required" or "`a` and `b` are required".
+func requiredText(names []string) string {
+	switch len(names) {
+	case 0:
+		return ""
+	case 1:
+		return codeNames(names) + " is required"
+	default:
+		return codeNames(names) + " are required"
+	}
+}
+
+// forbiddenText renders "`a` is not allowed" or "`a` and `b` are not allowed".
+func forbiddenText(names []string) string {
+	if len(names) == 1 {
+		return codeNames(names) + " is not allowed"
+	}
+
+	return codeNames(names) + " are not allowed"
+}
+
+// presentText renders "`name` is set".
+func presentText(name string) string {
+	return fmt.Sprintf("`%s` is set", escapeInline(name))
+}
+
+// codeNames renders property names as inline code joined with "and".
+func codeNames(names []string) string {
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("`%s`", escapeInline(name)))
+	}
+
+	return joinProse(parts, "and")
+}
+
+// proseValue renders JSON value as inline code; strings are shown without JSON quotes.
+func proseValue(value any) string {
+	return fmt.Sprintf("`%s`", escapeInline(discriminatorText(value)))
+}
+
+// proseValues renders JSON values as inline code joined with "or".
+func proseValues(values []any) string {
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		parts = append(parts, proseValue(value))
+	}
+
+	return joinProse(parts, "or")
+}
+
+// joinProse joins items as "a", "a and b" or "a, b and c".
+func joinProse(items []string, conjunction string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	default:
+		return strings.Join(items[:len(items)-1], ", ") + " " + conjunction + " " + items[len(items)-1]
+	}
+}
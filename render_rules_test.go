@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRenderExplainsConditionalRules(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render(minimalSchemaBytes(t, map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"mode": map[string]any{"enum": []any{"tls", "plain"}},
+			"cert": map[string]any{"type": "string"},
+			"key":  map[string]any{"type": "string"},
+			"port": map[string]any{"type": "integer"},
+		},
+		"if":   map[string]any{"properties": map[string]any{"mode": map[string]any{"const": "tls"}}},
+		"then": map[string]any{"required": []any{"cert"}},
+		"else": map[string]any{"properties": map[string]any{"cert": false}},
+		"allOf": []any{
+			map[string]any{
+				"if":   map[string]any{"required": []any{"port"}, "properties": map[string]any{"port": map[string]any{"minimum": 1}}},
+				"then": map[string]any{"required": []any{"key"}},
+			},
+		},
+		"dependentRequired": map[string]any{"cert": []any{"key", "port"}},
+		"dependentSchemas": map[string]any{
+			"key":  map[string]any{"required": []any{"cert"}},
+			"port": map[string]any{"maximum": 65535},
+		},
+	}), Options{})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "Rules:")
+	assertContains(t, rendered, "* When `mode` is `tls`, `cert` is required; otherwise, `cert` is not allowed.")
+	assertContains(t, rendered, "* When the `if` schema matches, `key` is required.")
+	assertContains(t, rendered, "* When `cert` is set, `key` and `port` are required.")
+	assertContains(t, rendered, "* When `key` is set, `cert` is required.")
+	assertContains(t, rendered, "* When `port` is set, the dependent schema applies.")
+	assertContains(t, rendered, "* Dependent required: 1")
+	assertNotContains(t, rendered, `{"cert":["key","port"]}`)
+}
+
+func TestSchemaRulesLegacyDependencies(t *testing.T) {
+	t.Parallel()
+
+	rules := schemaRules(schemaValue{Object: map[string]any{
+		"dependencies": map[string]any{
+			"billing": []any{"address"},
+			"proxy":   map[string]any{"not": map[string]any{"required": []any{"direct"}}},
+		},
+		"if":   map[string]any{"properties": map[string]any{"tier": map[string]any{"enum": []any{"gold", json.Number("1")}}}},
+		"else": map[string]any{"properties": map[string]any{"limit": map[string]any{"const": 10}}},
+	}}, nil)
+
+	want := []string{
+		"Unless `tier` is one of `gold` or `1`, `limit` must be `10`.",
+		"When `billing` is set, `address` is required.",
+		"When `proxy` is set, `direct` is not allowed.",
+	}
+	if len(rules) != len(want) {
+		t.Fatalf("rules = %q, want %q", rules, want)
+	}
+
+	for index := range want {
+		if rules[index] != want[index] {
+			t.Fatalf("rule %d = %q, want %q", index, rules[index], want[index])
+		}
+	}
+}
@@ -12,27 +12,42 @@ import (
 	"unicode"
 )
 
-// templateFS stores built-in markdown templates embedded into the package.
+// templateFS stores built-in templates embedded into the package.
 //
-//go:embed templates/*.md.gotmpl
+//go:embed templates/*.gotmpl
 var templateFS embed.FS
 
 // builtInTemplateFiles maps template aliases to embedded file paths.
 var builtInTemplateFiles = map[string]string{
-	templateListName:  "templates/list.md.gotmpl",
-	templateTableName: "templates/table.md.gotmpl",
+	templateListName:     "templates/list.md.gotmpl",
+	templateTableName:    "templates/table.md.gotmpl",
+	templateHTMLName:     "templates/page.html.gotmpl",
+	templateAsciiDocName: "templates/page.adoc.gotmpl",
+	templateRSTName:      "templates/page.rst.gotmpl",
+	templateManName:      "templates/page.man.gotmpl",
 }
 
-// resolveTemplate resolves either custom or built-in template text into a parsed template.
-func resolveTemplate(opt Options) (*template.Template, error) {
+// resolveTemplate resolves either custom or built-in template text into a parsed template
+// with helper functions of markdown, AsciiDoc, reStructuredText or man page output format.
+func resolveTemplate(opt Options, format OutputFormat) (*template.Template, error) {
+	funcs := templateFuncs()
+	switch format {
+	case OutputFormatAsciiDoc:
+		funcs = asciiDocTemplateFuncs()
+	case OutputFormatRST:
+		funcs = rstTemplateFuncs()
+	case OutputFormatMan:
+		funcs = manTemplateFuncs()
+	}
+
 	templateText := strings.TrimSpace(opt.TemplateText)
 	if templateText != "" {
-		return template.New("custom").Funcs(templateFuncs()).Parse(templateText)
+		return template.New("custom").Funcs(funcs).Parse(templateText)
 	}
 
 	templateName := normalizeTemplateName(opt.TemplateName)
 	if templateName == "" {
-		templateName = defaultTemplateName
+		templateName = defaultFormatTemplates[format]
 	}
 
 	templateText, err := BuiltinTemplate(templateName)
@@ -40,7 +55,7 @@ func resolveTemplate(opt Options) (*template.Template, error) {
 		return nil, err
 	}
 
-	parsed, err := template.New(templateName).Funcs(templateFuncs()).Parse(templateText)
+	parsed, err := template.New(templateName).Funcs(funcs).Parse(templateText)
 	if err != nil {
 		return nil, fmt.Errorf("%w %q: %w", ErrParseBuiltinTemplate, templateName, err)
 	}
@@ -54,12 +69,29 @@ func normalizeTemplateName(name string) string {
 }
 
 // templateFuncs provides utility functions available inside markdown templates.
+//
+// Link helpers `definitionHref`, `pathLink` and `refLink` are replaced
+// with ones bound to rendered definitions before execution, and `listMarker`
+// with one returning list marker of rendered view, usable inside `define` blocks.
 func templateFuncs() template.FuncMap {
 	return template.FuncMap{
 		"jsonInline": func(value any) string {
 			return escapeInline(mustJSONInline(value))
 		},
 		"headingAnchor": markdownHeadingAnchor,
+		"definitionHref": func(name string) string {
+			return "#" + markdownHeadingAnchor(name)
+		},
+		"pathLink": func(path string) string {
+			return "`" + path + "`"
+		},
+		"refLink": func(ref string) string {
+			return "`" + escapeInline(ref) + "`"
+		},
+		"mermaid": mermaidDiagram,
+		"listMarker": func() string {
+			return defaultListMarker
+		},
 	}
 }
 
@@ -6,6 +6,7 @@ package schemadoc
 
 import (
 	"encoding/json"
+	"errors"
 	"flag"
 	"os"
 	"path/filepath"
@@ -49,32 +50,149 @@ func TestDetectDraftUnsupported(t *testing.T) {
 	}
 }
 
-func TestDefinitionOrderRootFallbackConfig(t *testing.T) {
+func TestDefinitionOrderWithoutRootSortsByName(t *testing.T) {
 	t.Parallel()
 
-	order := definitionOrder(map[string]schemaValue{
+	defs := map[string]schemaValue{
 		"Zulu":   {},
 		"Config": {},
 		"Alpha":  {},
-	}, "")
+	}
 
-	got := strings.Join(order, ",")
-	want := "Config,Alpha,Zulu"
-	if got != want {
-		t.Fatalf("definition order = %q, want %q", got, want)
+	if got := strings.Join(definitionOrder(defs, ""), ","); got != "Alpha,Config,Zulu" {
+		t.Fatalf("definition order = %q, want %q", got, "Alpha,Config,Zulu")
+	}
+
+	if got := strings.Join(definitionOrder(defs, "Zulu"), ","); got != "Zulu,Alpha,Config" {
+		t.Fatalf("definition order = %q, want %q", got, "Zulu,Alpha,Config")
 	}
 }
 
-func TestPropertyOrderRequiredThenOptionalSorted(t *testing.T) {
+func TestRenderOmitsPathsWithoutRootDefinition(t *testing.T) {
 	t.Parallel()
 
-	order := propertyOrder([]string{"b", "a"}, map[string]schemaValue{
-		"d": {},
-		"c": {},
-		"b": {},
-		"a": {},
+	schemaBytes := minimalSchemaBytes(t, map[string]any{
+		"$defs": map[string]any{
+			"Agent": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"config": map[string]any{"$ref": "#/$defs/Config"},
+				},
+			},
+			"Config": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"level": map[string]any{"type": "string"},
+				},
+			},
+		},
 	})
 
+	rendered, err := Render(schemaBytes, Options{})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertOrdered(t, rendered, "## Agent", "## Config")
+	assertNotContains(t, rendered, "Path: ")
+
+	graph, err := RenderDOT(schemaBytes, Options{})
+	if err != nil {
+		t.Fatalf("RenderDOT: %v", err)
+	}
+
+	assertContains(t, graph, "  \"Agent\" -> \"Config\" [label=\"config\"];\n")
+	assertNotContains(t, graph, "style=bold")
+
+	rendered, err = Render(schemaBytes, Options{RootDefinition: "Agent"})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "Path: `config.level`")
+
+	rendered, err = Render(schemaBytes, Options{RootDefinition: "Config"})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertOrdered(t, rendered, "## Config", "## Agent")
+	assertNotContains(t, rendered, "config.level")
+}
+
+func TestPropertyOrderModes(t *testing.T) {
+	t.Parallel()
+
+	declared := []string{"d", "a", "c", "b"}
+	required := []string{"b", "a"}
+	tests := map[PropertyOrder]string{
+		PropertyOrderSource:         "d,a,c,b",
+		PropertyOrderRequiredFirst:  "a,b,d,c",
+		PropertyOrderAlphabetical:   "a,b,c,d",
+		PropertyOrderRequiredSorted: "b,a,c,d",
+	}
+
+	for mode, want := range tests {
+		got := strings.Join(propertyOrder(mode, declared, required), ",")
+		if got != want {
+			t.Fatalf("property order %q = %q, want %q", mode, got, want)
+		}
+	}
+}
+
+func TestRenderPreservesPropertyDeclarationOrder(t *testing.T) {
+	t.Parallel()
+
+	schemaBytes := []byte(`{
+  "type": "object",
+  "required": ["port"],
+  "properties": {
+    "name": { "type": "string" },
+    "port": { "type": "integer" },
+    "address": { "type": "string" }
+  }
+}`)
+
+	tests := map[PropertyOrder][]string{
+		"":                          {"name", "port", "address"},
+		PropertyOrderRequiredFirst:  {"port", "name", "address"},
+		PropertyOrderAlphabetical:   {"address", "name", "port"},
+		PropertyOrderRequiredSorted: {"port", "address", "name"},
+	}
+
+	for mode, keys := range tests {
+		rendered, err := Render(schemaBytes, Options{PropertyOrder: mode})
+		if err != nil {
+			t.Fatalf("Render(%q): %v", mode, err)
+		}
+
+		assertOrdered(t, rendered, "### Root."+keys[0], "### Root."+keys[1], "### Root."+keys[2])
+
+		example, err := GenerateExampleWithOptions(schemaBytes, Options{PropertyOrder: mode, ExampleFormat: ExampleFormatJSON})
+		if err != nil {
+			t.Fatalf("GenerateExampleWithOptions(%q): %v", mode, err)
+		}
+
+		assertOrdered(t, string(example), `"`+keys[0]+`"`, `"`+keys[1]+`"`, `"`+keys[2]+`"`)
+
+		example, err = GenerateExampleWithOptions(schemaBytes, Options{PropertyOrder: mode, ExampleFormat: ExampleFormatYAML})
+		if err != nil {
+			t.Fatalf("GenerateExampleWithOptions(%q): %v", mode, err)
+		}
+
+		assertOrdered(t, string(example), keys[0]+":", keys[1]+":", keys[2]+":")
+	}
+
+	if _, err := Render(schemaBytes, Options{PropertyOrder: "random"}); !errors.Is(err, ErrUnknownPropertyOrder) {
+		t.Fatalf("expected ErrUnknownPropertyOrder, got: %v", err)
+	}
+}
+
+func TestPropertyOrderRequiredThenOptionalSorted(t *testing.T) {
+	t.Parallel()
+
+	order := propertyOrder(PropertyOrderRequiredSorted, []string{"d", "c", "b", "a"}, []string{"b", "a"})
+
 	got := strings.Join(order, ",")
 	want := "b,a,c,d"
 	if got != want {
@@ -121,6 +239,73 @@ func TestRenderSupportsRootWithoutDefinitions(t *testing.T) {
 	assertContains(t, rendered, "### Root.name")
 }
 
+func TestRenderIncludesRootPropertiesWithDefinitions(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render(minimalSchemaBytes(t, map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"name":   map[string]any{"type": "string"},
+			"server": map[string]any{"$ref": "#/$defs/Server"},
+		},
+		"$defs": map[string]any{
+			"Config": map[string]any{"type": "object"},
+			"Server": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"host": map[string]any{"type": "string"},
+				},
+			},
+		},
+	}), Options{})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "### Root.name")
+	assertContains(t, rendered, "Path: `server.host`")
+	if strings.Index(rendered, "## Root") > strings.Index(rendered, "## Config") {
+		t.Fatalf("root section is not rendered first:\n%s", rendered)
+	}
+}
+
+func TestRenderRootDefinitionOverride(t *testing.T) {
+	t.Parallel()
+
+	schemaBytes := minimalSchemaBytes(t, map[string]any{
+		"$defs": map[string]any{
+			"Config": map[string]any{"type": "object"},
+			"Server": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"tls": map[string]any{"$ref": "#/$defs/TLS"},
+				},
+			},
+			"TLS": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"cert_file": map[string]any{"type": "string"},
+				},
+			},
+		},
+	})
+
+	rendered, err := Render(schemaBytes, Options{RootDefinition: "Server"})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "Path: `tls.cert_file`")
+	if strings.Index(rendered, "## Server") > strings.Index(rendered, "## Config") {
+		t.Fatalf("override root section is not rendered first:\n%s", rendered)
+	}
+
+	_, err = Render(schemaBytes, Options{RootDefinition: "Missing"})
+	if !errors.Is(err, ErrUnknownRootDefinition) {
+		t.Fatalf("expected ErrUnknownRootDefinition, got: %v", err)
+	}
+}
+
 func TestRenderIncludesBooleanAndReferences(t *testing.T) {
 	t.Parallel()
 
@@ -148,7 +333,7 @@ func TestRenderIncludesBooleanAndReferences(t *testing.T) {
 	}
 
 	assertContains(t, rendered, "Boolean schema: true")
-	assertContains(t, rendered, "Reference: `#/$defs/Target`")
+	assertContains(t, rendered, "Reference: [`#/$defs/Target`](#target)")
 	assertContains(t, rendered, "Dynamic reference: `#/$defs/Dyn`")
 	assertContains(t, rendered, "Recursive reference: `#/$defs/Rec`")
 }
@@ -457,6 +642,66 @@ func TestRenderWrapWidth(t *testing.T) {
 	assertContains(t, rendered, "length in markdown output.")
 }
 
+func TestRenderExpandsInlineNestedObjects(t *testing.T) {
+	t.Parallel()
+
+	schemaBytes := minimalSchemaBytes(t, map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"server": map[string]any{
+				"type":     "object",
+				"required": []any{"host"},
+				"properties": map[string]any{
+					"host": map[string]any{"type": "string", "description": "Listen host."},
+					"tls": map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"cert_file": map[string]any{"type": "string"},
+						},
+					},
+				},
+			},
+			"upstreams": map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"url": map[string]any{"type": "string"},
+					},
+				},
+			},
+		},
+	})
+
+	rendered, err := Render(schemaBytes, Options{})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "### Root.server.host")
+	assertContains(t, rendered, "Path: `server.host`")
+	assertContains(t, rendered, "Listen host.")
+	assertContains(t, rendered, "### Root.server.tls.cert_file")
+	assertContains(t, rendered, "Path: `server.tls.cert_file`")
+	assertContains(t, rendered, "### Root.upstreams.[].url")
+	assertContains(t, rendered, "Path: `upstreams.[].url`")
+
+	limited, err := Render(schemaBytes, Options{NestedDepth: 1})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, limited, "### Root.server.tls")
+	assertNotContains(t, limited, "### Root.server.tls.cert_file")
+
+	disabled, err := Render(schemaBytes, Options{NestedDepth: -1})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertNotContains(t, disabled, "### Root.server.host")
+}
+
 func TestRenderNoMultipleBlankLinesAfterPropertyHeading(t *testing.T) {
 	t.Parallel()
 
@@ -607,7 +852,7 @@ func TestBuiltinTemplates(t *testing.T) {
 	t.Parallel()
 
 	names := BuiltinTemplateNames()
-	if strings.Join(names, ",") != "list,table" {
+	if strings.Join(names, ",") != "asciidoc,html,list,man,rst,table" {
 		t.Fatalf("unexpected template names: %v", names)
 	}
 
@@ -696,6 +941,20 @@ func assertContains(t *testing.T, haystack, needle string) {
 	}
 }
 
+func assertOrdered(t *testing.T, haystack string, needles ...string) {
+	t.Helper()
+
+	offset := 0
+	for _, needle := range needles {
+		index := strings.Index(haystack[offset:], needle)
+		if index < 0 {
+			t.Fatalf("expected %q after offset %d in:\n%s", needle, offset, haystack)
+		}
+
+		offset += index + len(needle)
+	}
+}
+
 func assertNotContains(t *testing.T, haystack, needle string) {
 	t.Helper()
 
@@ -703,3 +962,101 @@ func assertNotContains(t *testing.T, haystack, needle string) {
 		t.Fatalf("unexpected substring %q in:\n%s", needle, haystack)
 	}
 }
+
+func TestRenderDocumentsMapEntries(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render(minimalSchemaBytes(t, map[string]any{
+		"$ref": "#/$defs/Config",
+		"$defs": map[string]any{
+			"Config": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"backends": map[string]any{
+						"type":                 "object",
+						"additionalProperties": map[string]any{"$ref": "#/$defs/Backend"},
+					},
+					"labels": map[string]any{
+						"type": "object",
+						"patternProperties": map[string]any{
+							"^x-": map[string]any{"type": "string", "description": "Extension label."},
+						},
+						"additionalProperties": false,
+					},
+				},
+			},
+			"Backend": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"url": map[string]any{"type": "string"},
+				},
+			},
+		},
+	}), Options{})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "### Config.backends.\\<name>")
+	assertContains(t, rendered, "Key: `<name>`")
+	assertContains(t, rendered, "Path: `backends.[]`")
+	assertContains(t, rendered, "### Config.labels.^x-")
+	assertContains(t, rendered, "Path: `labels.^x-`")
+	assertContains(t, rendered, "Extension label.")
+	assertContains(t, rendered, "Path: `backends.[].url`")
+	assertNotContains(t, rendered, "labels.\\<name>")
+}
+
+func TestRenderListsDefinitionBackReferences(t *testing.T) {
+	t.Parallel()
+
+	schemaBytes := minimalSchemaBytes(t, map[string]any{
+		"$ref": "#/$defs/Config",
+		"$defs": map[string]any{
+			"Config": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"tls":     map[string]any{"$ref": "#/$defs/TLSConfig"},
+					"servers": map[string]any{"type": "array", "items": map[string]any{"$ref": "#/$defs/Server"}},
+				},
+			},
+			"Server": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"tls":    map[string]any{"$ref": "#/$defs/TLSConfig"},
+					"backup": map[string]any{"$ref": "#/$defs/Server"},
+					"proxy": map[string]any{
+						"type":       "object",
+						"properties": map[string]any{"tls": map[string]any{"$ref": "#/$defs/TLSConfig"}},
+					},
+				},
+			},
+			"TLSConfig": map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"cert": map[string]any{"type": "string"}},
+			},
+		},
+	})
+
+	rendered, err := Render(schemaBytes, Options{})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "Referenced by:\n\n* [Config](#config): `servers.[]`\n* [Server](#server): `backup`\n")
+	assertContains(t, rendered, "Referenced by:\n\n* [Config](#config): `tls`\n* [Server](#server): `proxy.tls`, `tls`\n")
+
+	view, err := BuildView(schemaBytes, Options{})
+	if err != nil {
+		t.Fatalf("BuildView: %v", err)
+	}
+
+	if len(view.Definitions[0].ReferencedBy) != 0 {
+		t.Fatalf("root referenced by = %#v, want none", view.Definitions[0].ReferencedBy)
+	}
+
+	references := view.Definitions[2].ReferencedBy
+	if len(references) != 2 || references[1].Definition.Raw != "Server" || references[1].Anchor != "server" || len(references[1].Paths) != 2 {
+		t.Fatalf("TLSConfig referenced by = %#v", references)
+	}
+}
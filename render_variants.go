@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// variantKeywords lists composition keywords whose alternatives are rendered as variants.
+var variantKeywords = []string{"oneOf", "anyOf"}
+
+// variants builds views for oneOf/anyOf alternatives of schema node.
+//
+// Owner is the heading of section owning the variants. Base paths locate properties of inline
+// variants, which are expanded while depth allows it; their headings start with owner, keyword
+// and variant number, so equally named properties of different variants get distinct anchors.
+func (expander propertyExpander) variants(node schemaValue, owner string, basePaths []string, depth int) []variantView {
+	if node.Object == nil {
+		return nil
+	}
+
+	var out []variantView
+	for _, keyword := range variantKeywords {
+		items := make([]schemaValue, 0)
+		for _, raw := range asSlice(node.Object[keyword]) {
+			item, ok := toSchemaValue(raw)
+			if !ok {
+				continue
+			}
+
+			items = append(items, item)
+		}
+
+		if len(items) == 0 {
+			continue
+		}
+
+		discriminator := variantDiscriminator(expander.resolveVariants(items))
+		for index, item := range items {
+			label := variantLabel(item, index)
+			variant := variantView{
+				Keyword:     keyword,
+				Index:       index + 1,
+				Heading:     escapeHeading(fmt.Sprintf("%s %s %d: %s", owner, keyword, index+1, label)),
+				Label:       escapeInline(label),
+				Description: formatDescriptionMarkdown(nodeDescription(item), expander.wrapWidth, expander.listMarker),
+				Attributes:  schemaAttributes(item, nil),
+				Rules:       schemaRules(item, expander.keyOrder),
+			}
+
+			if discriminator != "" {
+				if value, ok := discriminatorValue(expander.resolveVariant(item), discriminator); ok {
+					variant.Condition = fmt.Sprintf("when `%s` = `%s`", escapeInline(discriminator), escapeInline(discriminatorText(value)))
+				}
+			}
+
+			if item.Object != nil {
+				if name := rootDefinitionName(schemaReference(item.Object)); name != "" {
+					variant.Reference = escapeInline(name)
+				} else if depth <= expander.maxDepth {
+					variantExpander := expander
+					variantExpander.definition = fmt.Sprintf("%s %s %d", owner, keyword, index+1)
+					variant.Properties = variantExpander.expand(nil, item, basePaths, "", depth)
+				}
+			}
+
+			out = append(out, variant)
+		}
+	}
+
+	return out
+}
+
+// resolveVariants resolves definition references of variant schemas.
+func (expander propertyExpander) resolveVariants(items []schemaValue) []schemaValue {
+	out := make([]schemaValue, 0, len(items))
+	for _, item := range items {
+		out = append(out, expander.resolveVariant(item))
+	}
+
+	return out
+}
+
+// resolveVariant returns referenced definition schema for `$ref` variant or variant itself.
+func (expander propertyExpander) resolveVariant(item schemaValue) schemaValue {
+	if item.Object == nil {
+		return item
+	}
+
+	if name := rootDefinitionName(schemaReference(item.Object)); name != "" {
+		if definition, ok := expander.definitions[name]; ok {
+			return definition
+		}
+	}
+
+	return item
+}
+
+// variantLabel returns variant title, referenced definition name, inferred type or ordinal fallback.
+func variantLabel(item schemaValue, index int) string {
+	if item.Bool != nil {
+		return "boolean schema " + strconv.FormatBool(*item.Bool)
+	}
+
+	if title := asString(item.Object["title"]); title != "" {
+		return title
+	}
+
+	if ref := schemaReference(item.Object); ref != "" {
+		if name := rootDefinitionName(ref); name != "" {
+			return name
+		}
+
+		return ref
+	}
+
+	if typeText := typeString(item.Object["type"]); typeText != "" {
+		return typeText
+	}
+
+	if len(nodeProperties(item)) > 0 {
+		return "object"
+	}
+
+	return "variant " + strconv.Itoa(index+1)
+}
+
+// variantDiscriminator returns property tagging every object variant with const or single enum value.
+//
+// At least two variants are required; first matching property in sorted order wins.
+func variantDiscriminator(items []schemaValue) string {
+	if len(items) < 2 {
+		return ""
+	}
+
+	for _, key := range sortedSchemaValueKeys(nodeProperties(items[0])) {
+		tagged := true
+		for _, item := range items {
+			if _, ok := discriminatorValue(item, key); !ok {
+				tagged = false
+				break
+			}
+		}
+
+		if tagged {
+			return key
+		}
+	}
+
+	return ""
+}
+
+// discriminatorValue returns const or single enum value of property in variant schema.
+func discriminatorValue(item schemaValue, key string) (any, bool) {
+	property, ok := nodeProperties(item)[key]
+	if !ok || property.Object == nil {
+		return nil, false
+	}
+
+	if value, ok := property.Object["const"]; ok {
+		return value, true
+	}
+
+	if enum := asSlice(property.Object["enum"]); len(enum) == 1 {
+		return enum[0], true
+	}
+
+	return nil, false
+}
+
+// discriminatorText formats discriminator value; strings are shown without JSON quotes.
+func discriminatorText(value any) string {
+	if text, ok := value.(string); ok {
+		return text
+	}
+
+	return mustJSONInline(value)
+}
@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderExpandsDefinitionVariantsWithDiscriminator(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render(minimalSchemaBytes(t, map[string]any{
+		"$ref": "#/$defs/Backend",
+		"$defs": map[string]any{
+			"Backend": map[string]any{
+				"description": "Storage backend.",
+				"oneOf": []any{
+					map[string]any{"$ref": "#/$defs/S3"},
+					map[string]any{
+						"title":    "Local disk",
+						"type":     "object",
+						"required": []any{"kind"},
+						"properties": map[string]any{
+							"kind": map[string]any{"const": "local"},
+							"path": map[string]any{"type": "string", "minLength": 1},
+						},
+					},
+				},
+			},
+			"S3": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"kind":   map[string]any{"enum": []any{"s3"}},
+					"bucket": map[string]any{"type": "string"},
+				},
+			},
+		},
+	}), Options{})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "### Backend oneOf 1: S3")
+	assertContains(t, rendered, "Applies when `kind` = `s3`.")
+	assertContains(t, rendered, "See [S3](#s3).")
+	assertContains(t, rendered, "### Backend oneOf 2: Local disk")
+	assertContains(t, rendered, "Applies when `kind` = `local`.")
+	assertContains(t, rendered, "#### Backend oneOf 2.path")
+	assertContains(t, rendered, "Constraints: at least 1 character")
+}
+
+func TestRenderLabelsPropertyVariantsByType(t *testing.T) {
+	t.Parallel()
+
+	rendered, err := Render(minimalSchemaBytes(t, map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"timeout": map[string]any{
+				"anyOf": []any{
+					map[string]any{"type": "integer", "minimum": 0},
+					map[string]any{"type": "string", "pattern": "^[0-9]+s$"},
+				},
+			},
+		},
+	}), Options{})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "#### Root.timeout anyOf 1: integer")
+	assertContains(t, rendered, "#### Root.timeout anyOf 2: string")
+	assertNotContains(t, rendered, "Applies when")
+}
+
+func TestRenderVariantPropertyAnchorsAreUnique(t *testing.T) {
+	t.Parallel()
+
+	shape := func(kind string) map[string]any {
+		return map[string]any{
+			"type":     "object",
+			"required": []any{"kind"},
+			"properties": map[string]any{
+				"kind": map[string]any{"const": kind},
+				"path": map[string]any{"type": "string"},
+			},
+		}
+	}
+
+	rendered, err := Render(minimalSchemaBytes(t, map[string]any{
+		"$ref": "#/$defs/Config",
+		"$defs": map[string]any{
+			"Config": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"backup": map[string]any{"oneOf": []any{shape("tar"), shape("zip")}},
+				},
+			},
+			"Storage": map[string]any{"oneOf": []any{shape("s3"), shape("gcs"), shape("local")}},
+		},
+	}), Options{})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	assertContains(t, rendered, "#### Storage oneOf 1.kind")
+	assertContains(t, rendered, "#### Storage oneOf 3.kind")
+	assertContains(t, rendered, "##### Config.backup oneOf 2.path")
+
+	anchors := make(map[string]string)
+	for _, line := range strings.Split(rendered, "\n") {
+		heading := strings.TrimLeft(line, "#")
+		if heading == line || !strings.HasPrefix(heading, " ") {
+			continue
+		}
+
+		anchor := markdownHeadingAnchor(heading)
+		if previous, ok := anchors[anchor]; ok {
+			t.Fatalf("headings %q and %q share anchor %q", previous, line, anchor)
+		}
+
+		anchors[anchor] = line
+	}
+}
+
+func TestRenderVariantOnlyDefinitionHasNoEmptyState(t *testing.T) {
+	t.Parallel()
+
+	schemaBytes := minimalSchemaBytes(t, map[string]any{
+		"$ref": "#/$defs/Storage",
+		"$defs": map[string]any{
+			"Storage": map[string]any{
+				"oneOf": []any{
+					map[string]any{
+						"type":       "object",
+						"properties": map[string]any{"bucket": map[string]any{"type": "string"}},
+						"dependentRequired": map[string]any{
+							"bucket": []any{"region"},
+						},
+					},
+					map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"path": map[string]any{
+								"type":              "object",
+								"properties":        map[string]any{"dir": map[string]any{"type": "string"}},
+								"dependentRequired": map[string]any{"dir": []any{"mode"}},
+							},
+						},
+					},
+				},
+			},
+			"Empty": map[string]any{"type": "object"},
+		},
+	})
+
+	for name, options := range map[string]Options{
+		"list":     {TemplateName: "list", ListMarker: "-"},
+		"table":    {TemplateName: "table"},
+		"html":     {Format: OutputFormatHTML},
+		"asciidoc": {Format: OutputFormatAsciiDoc},
+		"rst":      {Format: OutputFormatRST},
+		"man":      {Format: OutputFormatMan},
+	} {
+		rendered, err := Render(schemaBytes, options)
+		if err != nil {
+			t.Fatalf("Render(%s): %v", name, err)
+		}
+
+		if got := strings.Count(rendered, "No properties."); got != 1 {
+			t.Fatalf("Render(%s): %d empty states, want only one of Empty:\n%s", name, got, rendered)
+		}
+	}
+
+	for _, templateName := range []string{"list", "table"} {
+		rendered, err := Render(schemaBytes, Options{TemplateName: templateName, ListMarker: "-"})
+		if err != nil {
+			t.Fatalf("Render(%s): %v", templateName, err)
+		}
+
+		assertOrdered(t, rendered, "### Storage oneOf 1: object", "Rules:", "- When `bucket` is set", "### Storage oneOf 2: object", "#### Storage oneOf 2.path", "Rules:", "- When `dir` is set")
+		if got := strings.Count(rendered, "When `bucket` is set"); got != 1 {
+			t.Fatalf("Render(%s): variant rule rendered %d times:\n%s", templateName, got, rendered)
+		}
+	}
+}
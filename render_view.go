@@ -6,8 +6,11 @@ package schemadoc
 
 import (
 	"errors"
+	"fmt"
+	"maps"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -33,21 +36,36 @@ func buildRenderView(doc schemaDocument, opt Options) (renderView, error) {
 
 	wrapWidth := normalizeWrapWidth(opt.WrapWidth)
 	listMarker := normalizeListMarker(opt.ListMarker)
+	nestedDepth := normalizeNestedDepth(opt.NestedDepth)
+	order, err := normalizePropertyOrder(opt.PropertyOrder)
+	if err != nil {
+		return renderView{}, err
+	}
 
 	sourcePath := strings.TrimSpace(opt.SourcePath)
 	if sourcePath == "" {
 		sourcePath = "(memory)"
 	}
 
-	rootName := rootDefinitionName(doc.Ref)
-	definitions := renderDefinitions(doc, rootName)
+	definitions, rootName, err := renderDefinitions(doc, opt.RootDefinition)
+	if err != nil {
+		return renderView{}, err
+	}
+
 	defOrder := definitionOrder(definitions, rootName)
 	if len(defOrder) == 0 {
 		return renderView{}, errors.New("schema has no definitions to render")
 	}
 
-	rootDefinition := defOrder[0]
+	documented, defOrder, err := filterDefinitions(definitions, defOrder, rootName, opt)
+	if err != nil {
+		return renderView{}, err
+	}
+
+	// Without root, property paths have no origin, so they are omitted.
+	rootDefinition := rootName
 	definitionPaths := buildDefinitionPaths(definitions, rootDefinition)
+	referrers := definitionReferrers(documented, defOrder)
 
 	view := renderView{
 		Title:              sanitizeText(title),
@@ -57,6 +75,8 @@ func buildRenderView(doc schemaDocument, opt Options) (renderView, error) {
 		SchemaDraftSupport: draftSupportText(doc.Draft),
 		RootRef:            escapeInline(orNone(doc.Ref)),
 		ListMarker:         listMarker,
+		Graph:              buildDefinitionGraph(documented, defOrder, rootDefinition, opt.DiagramDepth),
+		Contents:           make([]contentsEntry, 0, len(defOrder)),
 		Definitions:        make([]definitionView, 0, len(defOrder)),
 	}
 
@@ -67,39 +87,37 @@ func buildRenderView(doc schemaDocument, opt Options) (renderView, error) {
 		}
 
 		definition := definitionView{
-			Name:        escapeInline(defName),
-			Description: formatDescriptionMarkdown(nodeDescription(node), wrapWidth, listMarker),
-			Attributes:  schemaAttributes(node, nil),
+			Name:         escapeInline(defName),
+			Description:  formatDescriptionMarkdown(nodeDescription(node), wrapWidth, listMarker),
+			Attributes:   schemaAttributes(node, nil),
+			Rules:        schemaRules(node, doc.KeyOrder),
+			ReferencedBy: referrers[defName],
 		}
 
-		properties := nodeProperties(node)
-		required := nodeRequired(node)
-		order := propertyOrder(required, properties)
-		definition.HasProperties = len(order) > 0
-		definition.Properties = make([]propertyView, 0, len(order))
-
-		basePaths := definitionPaths[defName]
-		isRootDefinition := defName == rootDefinition
-		for _, propName := range order {
-			prop := properties[propName]
-			propRequired := isRequired(required, propName)
-
-			paths := buildPropertyPaths(basePaths, propName, isRootDefinition)
-			escapedPaths := make([]string, 0, len(paths))
-			for _, path := range paths {
-				escapedPaths = append(escapedPaths, escapeInline(path))
-			}
-
-			definition.Properties = append(definition.Properties, propertyView{
-				Heading:     escapeInline(defName + "." + propertyHeadingName(propName, prop)),
-				Name:        escapeInline(propName),
-				Paths:       escapedPaths,
-				Description: formatDescriptionMarkdown(nodeDescription(prop), wrapWidth, listMarker),
-				Attributes:  schemaAttributes(prop, &propRequired),
-			})
+		expander := propertyExpander{
+			definition:     defName,
+			rootDefinition: defName == rootDefinition,
+			wrapWidth:      wrapWidth,
+			listMarker:     listMarker,
+			maxDepth:       nestedDepth,
+			order:          order,
+			keyOrder:       doc.KeyOrder,
+			definitions:    definitions,
 		}
 
+		definition.Properties = expander.expand(nil, node, definitionPaths[defName], "", 0)
+		definition.Variants = expander.variants(node, defName, definitionPaths[defName], 0)
+		definition.HasProperties = len(definition.Properties) > 0
+
 		view.Definitions = append(view.Definitions, definition)
+		view.Contents = append(view.Contents, contentsEntry{
+			Name: definition.Name,
+			Href: "#" + markdownHeadingAnchor(definition.Name),
+		})
+	}
+
+	if opt.SettingsIndex {
+		view.Index = buildSettingsIndex(view.Definitions)
 	}
 
 	if len(view.Definitions) == 0 {
@@ -109,13 +127,190 @@ func buildRenderView(doc schemaDocument, opt Options) (renderView, error) {
 	return view, nil
 }
 
+// propertyExpander builds property views of one definition including inline nested objects.
+type propertyExpander struct {
+	keyOrder       keyOrder
+	definitions    map[string]schemaValue
+	definition     string
+	order          PropertyOrder
+	listMarker     string
+	wrapWidth      int
+	maxDepth       int
+	rootDefinition bool
+}
+
+// expand appends property views of schema node and recurses into inline nested objects.
+//
+// Key prefix is the dotted property path inside definition, for example `server.` or `servers.[].`.
+// Pattern and additional properties follow declared properties as map entries.
+func (expander propertyExpander) expand(out []propertyView, node schemaValue, basePaths []string, keyPrefix string, depth int) []propertyView {
+	properties := nodeProperties(node)
+	required := nodeRequired(node)
+	for _, propName := range propertyOrder(expander.order, expander.declaredProperties(node), required) {
+		prop, ok := properties[propName]
+		if !ok {
+			continue
+		}
+
+		propRequired := isRequired(required, propName)
+		heading := expander.definition + "." + keyPrefix + propertyHeadingName(propName, prop)
+		out = expander.entry(out, mapEntry{Key: propName, Segment: propName, Schema: prop}, &propRequired, heading, basePaths, keyPrefix, depth)
+	}
+
+	for _, entry := range expander.mapEntries(node) {
+		heading := expander.definition + "." + keyPrefix + entry.Key
+		out = expander.entry(out, entry, nil, heading, basePaths, keyPrefix, depth)
+	}
+
+	return out
+}
+
+// entry appends view of one property or map entry and recurses into its inline nested object.
+//
+// Headings use entry key, property paths use entry path segment.
+func (expander propertyExpander) entry(out []propertyView, item mapEntry, required *bool, heading string, basePaths []string, keyPrefix string, depth int) []propertyView {
+	key, prop := item.Key, item.Schema
+	paths := buildPropertyPaths(basePaths, item.Segment, expander.rootDefinition && depth == 0)
+	escapedPaths := make([]string, 0, len(paths))
+	for _, path := range paths {
+		escapedPaths = append(escapedPaths, escapeInline(path))
+	}
+
+	propPaths := appendPathSegments(basePaths, []string{item.Segment})
+	out = append(out, propertyView{
+		Heading:     escapeHeading(heading),
+		Name:        escapeInline(key),
+		Paths:       escapedPaths,
+		Description: formatDescriptionMarkdown(nodeDescription(prop), expander.wrapWidth, expander.listMarker),
+		Attributes:  schemaAttributes(prop, required),
+		Rules:       schemaRules(prop, expander.keyOrder),
+		Variants:    expander.variants(prop, heading, propPaths, depth+1),
+		Depth:       depth,
+	})
+
+	if depth >= expander.maxDepth {
+		return out
+	}
+
+	nested, segments := inlineNestedObject(prop)
+	if nested.isZero() {
+		return out
+	}
+
+	childPaths := appendPathSegments(basePaths, append([]string{item.Segment}, segments...))
+	childPrefix := keyPrefix + strings.Join(append([]string{key}, segments...), ".") + "."
+	return expander.expand(out, nested, childPaths, childPrefix, depth+1)
+}
+
+// mapEntry is one property, patternProperties or additionalProperties schema documented as property.
+//
+// Segment is property path segment of entry: property key, regex or `[]` for additionalProperties,
+// matching definition paths.
+type mapEntry struct {
+	Key     string
+	Segment string
+	Schema  schemaValue
+}
+
+// mapEntries returns pattern properties keyed by regex in declaration order,
+// followed by additionalProperties schema keyed by `<name>` with `[]` path segment.
+//
+// Boolean additionalProperties only allow or forbid extra keys and stay attributes.
+func (expander propertyExpander) mapEntries(node schemaValue) []mapEntry {
+	if node.Object == nil {
+		return nil
+	}
+
+	out := make([]mapEntry, 0)
+	if raw, ok := node.Object["patternProperties"].(map[string]any); ok {
+		for _, pattern := range expander.keyOrder.keys(raw) {
+			value, ok := toSchemaValue(raw[pattern])
+			if !ok || value.Object == nil {
+				continue
+			}
+
+			out = append(out, mapEntry{Key: pattern, Segment: pattern, Schema: value})
+		}
+	}
+
+	if value, ok := toSchemaValue(node.Object["additionalProperties"]); ok && value.Object != nil {
+		out = append(out, mapEntry{Key: additionalPropertiesKey, Segment: "[]", Schema: value})
+	}
+
+	return out
+}
+
+// declaredProperties returns property names of schema node in source declaration order.
+func (expander propertyExpander) declaredProperties(node schemaValue) []string {
+	if node.Object == nil {
+		return nil
+	}
+
+	raw, ok := node.Object["properties"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	return expander.keyOrder.keys(raw)
+}
+
+// inlineNestedObject returns inline object schema with own properties under property schema.
+//
+// Arrays of inline objects return item schema with `[]` path segment.
+// Referenced schemas are documented in their own definitions and are not expanded.
+func inlineNestedObject(prop schemaValue) (schemaValue, []string) {
+	if prop.Object == nil || schemaReference(prop.Object) != "" {
+		return schemaValue{}, nil
+	}
+
+	if hasNestedEntries(prop) {
+		return prop, nil
+	}
+
+	items, ok := toSchemaValue(prop.Object["items"])
+	if !ok || items.Object == nil || schemaReference(items.Object) != "" || !hasNestedEntries(items) {
+		return schemaValue{}, nil
+	}
+
+	return items, []string{"[]"}
+}
+
+// hasNestedEntries reports whether schema object declares properties or map entry schemas.
+func hasNestedEntries(node schemaValue) bool {
+	if len(nodeProperties(node)) > 0 {
+		return true
+	}
+
+	if len(mapSchemaValues(node.Object["patternProperties"])) > 0 {
+		return true
+	}
+
+	_, ok := node.Object["additionalProperties"].(map[string]any)
+	return ok
+}
+
+// appendPathSegments appends path segments to every base path.
+func appendPathSegments(basePaths []string, segments []string) []string {
+	out := make([]string, 0, len(basePaths))
+	for _, base := range basePaths {
+		path := base
+		for _, segment := range segments {
+			path = appendPath(path, segment)
+		}
+
+		out = append(out, path)
+	}
+
+	return out
+}
+
 // propertyHeadingName selects property heading suffix based on referenced definition name.
 func propertyHeadingName(key string, prop schemaValue) string {
 	if prop.Object == nil {
 		return key
 	}
 
-	refName := rootDefinitionName(asString(prop.Object["$ref"]))
+	refName := rootDefinitionName(schemaReference(prop.Object))
 	if refName != "" {
 		return refName
 	}
@@ -191,6 +386,42 @@ func buildDefinitionPaths(definitions map[string]schemaValue, rootDefinition str
 	return paths
 }
 
+// definitionReferrers maps definition names to definitions referencing them in rendering order.
+//
+// Every referencing definition is listed once with sorted property paths of its references.
+func definitionReferrers(definitions map[string]schemaValue, order []string) map[string][]referenceView {
+	referrers := make(map[string][]referenceView)
+	for _, source := range order {
+		node := definitions[source]
+		if node.isZero() {
+			continue
+		}
+
+		paths := make(map[string][]string)
+		targets := make([]string, 0)
+		for _, edge := range definitionEdges(node) {
+			if _, ok := definitions[edge.Target]; !ok {
+				continue
+			}
+
+			if _, ok := paths[edge.Target]; !ok {
+				targets = append(targets, edge.Target)
+			}
+
+			paths[edge.Target] = append(paths[edge.Target], escapeInline(edge.Path))
+		}
+
+		for _, target := range targets {
+			referrers[target] = append(referrers[target], referenceView{
+				Definition: escapeInline(source),
+				Paths:      paths[target],
+			})
+		}
+	}
+
+	return referrers
+}
+
 // buildPropertyPaths builds normalized root-relative JSON paths for one property.
 func buildPropertyPaths(basePaths []string, propertyName string, hideRootPath bool) []string {
 	propertyName = strings.TrimSpace(propertyName)
@@ -271,7 +502,7 @@ func collectDefinitionEdges(schema schemaValue, path string, edgeMap map[string]
 	}
 
 	object := schema.Object
-	if target := rootDefinitionName(asString(object["$ref"])); target != "" {
+	if target := rootDefinitionName(schemaReference(object)); target != "" {
 		addDefinitionEdge(edgeMap, path, target)
 	}
 
@@ -361,18 +592,46 @@ func sortedSchemaValueKeys(values map[string]schemaValue) []string {
 	return out
 }
 
-// renderDefinitions returns definitions map and synthesizes root when schema has none.
-func renderDefinitions(doc schemaDocument, rootName string) map[string]schemaValue {
-	if len(doc.Defs) > 0 {
-		return doc.Defs
+// renderDefinitions returns definitions map with root definition name.
+//
+// Root schema is synthesized as own section when it has no `$ref` to definition
+// and either declares properties or schema has no definitions at all.
+// Non-empty override selects root definition explicitly.
+func renderDefinitions(doc schemaDocument, override string) (map[string]schemaValue, string, error) {
+	definitions := make(map[string]schemaValue, len(doc.Defs)+1)
+	maps.Copy(definitions, doc.Defs)
+
+	rootName := rootDefinitionName(doc.Ref)
+	if _, ok := definitions[rootName]; !ok {
+		rootName = ""
+	}
+
+	if rootName == "" && (len(doc.Defs) == 0 || len(nodeProperties(doc.Root)) > 0) {
+		rootName = rootSectionName(definitions)
+		definitions[rootName] = doc.Root
 	}
 
-	name := strings.TrimSpace(rootName)
-	if name == "" {
-		name = "Root"
+	if name := strings.TrimSpace(override); name != "" {
+		if _, ok := definitions[name]; !ok {
+			return nil, "", fmt.Errorf("%w %q", ErrUnknownRootDefinition, name)
+		}
+
+		rootName = name
 	}
 
-	return map[string]schemaValue{name: doc.Root}
+	return definitions, rootName, nil
+}
+
+// rootSectionName returns unique section name for synthesized root schema.
+func rootSectionName(definitions map[string]schemaValue) string {
+	name := "Root"
+	for index := 2; ; index++ {
+		if _, taken := definitions[name]; !taken {
+			return name
+		}
+
+		name = "Root_" + strconv.Itoa(index)
+	}
 }
 
 // draftSupportText formats draft support marker for markdown metadata block.
@@ -389,6 +648,8 @@ func draftSupportText(info DraftInfo) string {
 }
 
 // definitionOrder returns deterministic definition rendering order with root first.
+//
+// Without root name definitions are sorted by name; use Options.RootDefinition to select root.
 func definitionOrder(defs map[string]schemaValue, rootName string) []string {
 	keys := make([]string, 0, len(defs))
 	for name := range defs {
@@ -401,14 +662,6 @@ func definitionOrder(defs map[string]schemaValue, rootName string) []string {
 	}
 
 	root := strings.TrimSpace(rootName)
-	if root == "" {
-		if _, ok := defs["Config"]; ok {
-			root = "Config"
-		} else {
-			root = keys[0]
-		}
-	}
-
 	if _, ok := defs[root]; !ok {
 		return keys
 	}
@@ -426,43 +679,7 @@ func definitionOrder(defs map[string]schemaValue, rootName string) []string {
 	return out
 }
 
-// propertyOrder returns required properties first, then optional sorted properties.
-func propertyOrder(required []string, properties map[string]schemaValue) []string {
-	if len(properties) == 0 {
-		return nil
-	}
-
-	out := make([]string, 0, len(properties))
-	seen := make(map[string]struct{}, len(properties))
-
-	for _, name := range required {
-		if _, ok := properties[name]; !ok {
-			continue
-		}
-
-		if _, exists := seen[name]; exists {
-			continue
-		}
-
-		seen[name] = struct{}{}
-		out = append(out, name)
-	}
-
-	optional := make([]string, 0, len(properties))
-	for name := range properties {
-		if _, exists := seen[name]; exists {
-			continue
-		}
-
-		optional = append(optional, name)
-	}
-
-	sort.Strings(optional)
-	out = append(out, optional...)
-	return out
-}
-
-// rootDefinitionName extracts definition name from local JSON pointer reference.
+// rootDefinitionName extracts definition name from local JSON pointer to whole definition.
 func rootDefinitionName(ref string) string {
 	ref = strings.TrimSpace(ref)
 	if ref == "" {
@@ -479,8 +696,12 @@ func rootDefinitionName(ref string) string {
 			return ""
 		}
 
-		parts := strings.Split(path, "/")
-		return parts[0]
+		name, rest, _ := strings.Cut(path, "/")
+		if rest != "" {
+			return ""
+		}
+
+		return decodeJSONPointerToken(name)
 	}
 
 	return ""
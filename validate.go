@@ -0,0 +1,311 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxValidationDepth stops `$ref` recursion of self-referencing schemas on malformed instances.
+const maxValidationDepth = 64
+
+// instanceValidator checks decoded JSON or YAML value against JSON Schema.
+//
+// It covers keywords used by reflected package models: local `$ref`, type, enum, const,
+// properties, required, additionalProperties, items, length and range limits, pattern
+// and allOf/anyOf/oneOf. Annotations and unknown keywords are ignored.
+type instanceValidator struct {
+	root       any
+	violations []string
+}
+
+// validateInstance returns violations of instance against schema; root resolves local `$ref` pointers.
+func validateInstance(root, schema, instance any) []string {
+	validator := &instanceValidator{root: root}
+	validator.validate(schema, instance, "", 0)
+	return validator.violations
+}
+
+// matches reports whether instance is valid against schema without recording violations.
+func (validator *instanceValidator) matches(schema, instance any, location string, depth int) bool {
+	nested := &instanceValidator{root: validator.root}
+	nested.validate(schema, instance, location, depth)
+	return len(nested.violations) == 0
+}
+
+// report records violation of value at JSON pointer location.
+func (validator *instanceValidator) report(location, format string, args ...any) {
+	if location == "" {
+		location = "/"
+	}
+
+	validator.violations = append(validator.violations, location+": "+fmt.Sprintf(format, args...))
+}
+
+// validate checks instance against schema keywords and subschemas.
+func (validator *instanceValidator) validate(schema, instance any, location string, depth int) {
+	if flag, ok := asBool(schema); ok {
+		if !flag {
+			validator.report(location, "value is not allowed")
+		}
+
+		return
+	}
+
+	object, ok := schema.(map[string]any)
+	if !ok {
+		return
+	}
+
+	if ref := asString(object["$ref"]); ref != "" {
+		target, ok := resolveJSONPointer(validator.root, ref)
+		if !ok || depth >= maxValidationDepth {
+			validator.report(location, "unresolved schema reference %q", ref)
+			return
+		}
+
+		validator.validate(target, instance, location, depth+1)
+	}
+
+	if !validator.validateType(object, instance, location) {
+		return
+	}
+
+	validator.validateValue(object, instance, location)
+	validator.validateCompositions(object, instance, location, depth)
+
+	switch typed := instance.(type) {
+	case map[string]any:
+		validator.validateObject(object, typed, location, depth)
+	case []any:
+		validator.validateArray(object, typed, location, depth)
+	}
+}
+
+// validateType checks `type` keyword and reports whether remaining keywords are worth checking.
+func (validator *instanceValidator) validateType(schema map[string]any, instance any, location string) bool {
+	types := asStringSlice(schema["type"])
+	if single := asString(schema["type"]); single != "" {
+		types = []string{single}
+	}
+
+	if len(types) == 0 {
+		return true
+	}
+
+	actual := instanceType(instance)
+	for _, expected := range types {
+		if expected == actual || (expected == "number" && actual == "integer") {
+			return true
+		}
+	}
+
+	validator.report(location, "expected %s, got %s", strings.Join(types, " or "), actual)
+	return false
+}
+
+// validateValue checks enum, const, string length, pattern and numeric range keywords.
+func (validator *instanceValidator) validateValue(schema map[string]any, instance any, location string) {
+	if options, ok := schema["enum"].([]any); ok && !slices.ContainsFunc(options, func(option any) bool {
+		return instanceEqual(option, instance)
+	}) {
+		validator.report(location, "value %s is not one of %s", instanceText(instance), instanceList(options))
+	}
+
+	if constant, ok := schema["const"]; ok && !instanceEqual(constant, instance) {
+		validator.report(location, "value %s is not %s", instanceText(instance), instanceText(constant))
+	}
+
+	switch typed := instance.(type) {
+	case string:
+		length := float64(utf8.RuneCountInString(typed))
+		if limit, ok := schemaNumber(schema["minLength"]); ok && length < limit {
+			validator.report(location, "string is shorter than %s characters", instanceText(schema["minLength"]))
+		}
+
+		if limit, ok := schemaNumber(schema["maxLength"]); ok && length > limit {
+			validator.report(location, "string is longer than %s characters", instanceText(schema["maxLength"]))
+		}
+
+		if pattern := asString(schema["pattern"]); pattern != "" {
+			if expression, err := regexp.Compile(pattern); err == nil && !expression.MatchString(typed) {
+				validator.report(location, "value %q does not match pattern %q", typed, pattern)
+			}
+		}
+	case json.Number:
+		value, _ := schemaNumber(typed)
+		if limit, ok := schemaNumber(schema["minimum"]); ok && value < limit {
+			validator.report(location, "value %s is less than minimum %s", typed, instanceText(schema["minimum"]))
+		}
+
+		if limit, ok := schemaNumber(schema["maximum"]); ok && value > limit {
+			validator.report(location, "value %s is greater than maximum %s", typed, instanceText(schema["maximum"]))
+		}
+
+		if limit, ok := schemaNumber(schema["exclusiveMinimum"]); ok && value <= limit {
+			validator.report(location, "value %s is not greater than %s", typed, instanceText(schema["exclusiveMinimum"]))
+		}
+
+		if limit, ok := schemaNumber(schema["exclusiveMaximum"]); ok && value >= limit {
+			validator.report(location, "value %s is not less than %s", typed, instanceText(schema["exclusiveMaximum"]))
+		}
+	}
+}
+
+// validateCompositions checks allOf, anyOf and oneOf subschemas.
+func (validator *instanceValidator) validateCompositions(schema map[string]any, instance any, location string, depth int) {
+	for _, subschema := range asSlice(schema["allOf"]) {
+		validator.validate(subschema, instance, location, depth+1)
+	}
+
+	if alternatives := asSlice(schema["anyOf"]); len(alternatives) > 0 && !slices.ContainsFunc(alternatives, func(alternative any) bool {
+		return validator.matches(alternative, instance, location, depth+1)
+	}) {
+		validator.report(location, "value does not match any anyOf alternative")
+	}
+
+	if alternatives := asSlice(schema["oneOf"]); len(alternatives) > 0 {
+		matched := 0
+		for _, alternative := range alternatives {
+			if validator.matches(alternative, instance, location, depth+1) {
+				matched++
+			}
+		}
+
+		if matched != 1 {
+			validator.report(location, "value matches %d oneOf alternatives, expected exactly one", matched)
+		}
+	}
+}
+
+// validateObject checks required, properties and additionalProperties keywords in sorted key order.
+func (validator *instanceValidator) validateObject(schema map[string]any, instance map[string]any, location string, depth int) {
+	for _, name := range asStringSlice(schema["required"]) {
+		if _, ok := instance[name]; !ok {
+			validator.report(location, "missing required property %q", name)
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+	for _, key := range sortedKeys(instance) {
+		childLocation := location + "/" + encodeJSONPointerToken(key)
+		if property, ok := properties[key]; ok {
+			validator.validate(property, instance[key], childLocation, depth+1)
+			continue
+		}
+
+		additional, ok := schema["additionalProperties"]
+		if !ok {
+			continue
+		}
+
+		if flag, isBool := asBool(additional); isBool && !flag {
+			validator.report(location, "property %q is not allowed", key)
+			continue
+		}
+
+		validator.validate(additional, instance[key], childLocation, depth+1)
+	}
+}
+
+// validateArray checks items and item count keywords.
+func (validator *instanceValidator) validateArray(schema map[string]any, instance []any, location string, depth int) {
+	count := float64(len(instance))
+	if limit, ok := schemaNumber(schema["minItems"]); ok && count < limit {
+		validator.report(location, "array has fewer than %s items", instanceText(schema["minItems"]))
+	}
+
+	if limit, ok := schemaNumber(schema["maxItems"]); ok && count > limit {
+		validator.report(location, "array has more than %s items", instanceText(schema["maxItems"]))
+	}
+
+	items, ok := schema["items"]
+	if !ok {
+		return
+	}
+
+	for index, item := range instance {
+		validator.validate(items, item, location+"/"+strconv.Itoa(index), depth+1)
+	}
+}
+
+// instanceType returns JSON Schema type name of decoded value; integral numbers are `integer`.
+func instanceType(instance any) string {
+	switch typed := instance.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	case json.Number:
+		if _, err := typed.Int64(); err == nil {
+			return "integer"
+		}
+
+		if value, err := typed.Float64(); err == nil && value == float64(int64(value)) {
+			return "integer"
+		}
+
+		return "number"
+	default:
+		return fmt.Sprintf("%T", instance)
+	}
+}
+
+// schemaNumber converts json.Number or float64 keyword value to float64.
+func schemaNumber(value any) (float64, bool) {
+	switch typed := value.(type) {
+	case json.Number:
+		number, err := typed.Float64()
+		return number, err == nil
+	case float64:
+		return typed, true
+	default:
+		return 0, false
+	}
+}
+
+// instanceEqual compares decoded values; numbers are compared by value.
+func instanceEqual(left, right any) bool {
+	leftNumber, leftOK := schemaNumber(left)
+	rightNumber, rightOK := schemaNumber(right)
+	if leftOK || rightOK {
+		return leftOK && rightOK && leftNumber == rightNumber
+	}
+
+	return reflect.DeepEqual(left, right)
+}
+
+// instanceText formats decoded value as compact JSON for violation messages.
+func instanceText(value any) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(encoded)
+}
+
+// instanceList formats enum options as comma separated JSON values.
+func instanceList(values []any) string {
+	texts := make([]string, 0, len(values))
+	for _, value := range values {
+		texts = append(texts, instanceText(value))
+	}
+
+	return strings.Join(texts, ", ")
+}
@@ -0,0 +1,448 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ViewVersion is version of View model; it is incremented on incompatible field changes.
+const ViewVersion = 1
+
+// View is documentation model computed from schema, the same data built-in templates render.
+//
+// It is JSON-serializable for non-Go tooling; text values carry both
+// markdown-escaped form used by templates and raw unescaped form.
+type View struct {
+	// Title is document title.
+	Title string `json:"title" jsonschema:"example=schema reference"`
+
+	// SourceSchema is schema source path shown in document header.
+	SourceSchema ViewText `json:"source_schema"`
+
+	// SchemaID is root `$id` value or `(none)`.
+	SchemaID ViewText `json:"schema_id"`
+
+	// SchemaDraft is root `$schema` value or `(none)`.
+	SchemaDraft ViewText `json:"schema_draft"`
+
+	// DraftSupport describes detected draft support, for example `supported (2020-12)`.
+	DraftSupport ViewText `json:"draft_support"`
+
+	// RootRef is root `$ref` value or `(none)`.
+	RootRef ViewText `json:"root_ref"`
+
+	// Example is embedded example payload, present when Options.ExampleFormat is set.
+	Example *ViewExample `json:"example,omitempty"`
+
+	// Definitions lists documented definitions, root definition first.
+	Definitions []DefinitionView `json:"definitions"`
+
+	// Index lists every leaf property path sorted by path, present when Options.SettingsIndex is set.
+	Index []IndexEntryView `json:"index,omitempty"`
+
+	// Version is view model version, equal to ViewVersion.
+	Version int `json:"version" jsonschema:"example=1"`
+}
+
+// ViewText is one text value in markdown-escaped and raw unescaped form.
+type ViewText struct {
+	// Markdown is value as rendered by markdown templates, with markdown escapes and code spans.
+	Markdown string `json:"markdown"`
+
+	// Raw is plain text with markdown escapes and markup removed.
+	Raw string `json:"raw"`
+}
+
+// ViewExample is generated example payload embedded into documentation.
+type ViewExample struct {
+	// Format is payload encoding.
+	Format ExampleFormat `json:"format" jsonschema:"enum=json,enum=yaml"`
+
+	// Document is encoded example payload.
+	Document string `json:"document"`
+}
+
+// DefinitionView is one documented definition section.
+type DefinitionView struct {
+	// Name is definition name.
+	Name ViewText `json:"name"`
+
+	// Anchor is heading anchor of definition section.
+	Anchor string `json:"anchor"`
+
+	// Description is normalized definition description.
+	Description ViewText `json:"description"`
+
+	// Attributes lists definition metadata such as type and constraints.
+	Attributes []AttributeView `json:"attributes,omitempty"`
+
+	// Rules lists conditional and dependency rules in prose.
+	Rules []ViewText `json:"rules,omitempty"`
+
+	// Variants lists oneOf/anyOf alternatives of definition.
+	Variants []VariantView `json:"variants,omitempty"`
+
+	// Properties lists documented properties including inline nested ones.
+	Properties []PropertyView `json:"properties,omitempty"`
+
+	// ReferencedBy lists definitions referencing this one.
+	ReferencedBy []ReferenceView `json:"referenced_by,omitempty"`
+}
+
+// ReferenceView is one definition referencing another one.
+type ReferenceView struct {
+	// Definition is name of referencing definition.
+	Definition ViewText `json:"definition"`
+
+	// Anchor is heading anchor of referencing definition section.
+	Anchor string `json:"anchor"`
+
+	// Paths lists dotted property paths of referencing definition holding reference.
+	Paths []ViewText `json:"paths"`
+}
+
+// PropertyView is one documented property entry.
+type PropertyView struct {
+	// Key is property key, regex for patternProperties or `<name>` for additionalProperties;
+	// path segment of additionalProperties entries is `[]`.
+	Key ViewText `json:"key"`
+
+	// Heading is section heading, definition name followed by dotted key chain.
+	Heading ViewText `json:"heading"`
+
+	// Anchor is heading anchor of property section.
+	Anchor string `json:"anchor"`
+
+	// Paths lists dotted paths of property from root definition.
+	Paths []ViewText `json:"paths,omitempty"`
+
+	// Description is normalized property description.
+	Description ViewText `json:"description"`
+
+	// Attributes lists property metadata such as type, default and constraints.
+	Attributes []AttributeView `json:"attributes,omitempty"`
+
+	// Rules lists conditional and dependency rules in prose.
+	Rules []ViewText `json:"rules,omitempty"`
+
+	// Variants lists oneOf/anyOf alternatives of property.
+	Variants []VariantView `json:"variants,omitempty"`
+
+	// Depth is nesting level of inline nested property, zero for direct properties.
+	Depth int `json:"depth"`
+}
+
+// VariantView is one oneOf/anyOf alternative.
+type VariantView struct {
+	// Keyword is composition keyword, `oneOf` or `anyOf`.
+	Keyword string `json:"keyword" jsonschema:"enum=oneOf,enum=anyOf"`
+
+	// Heading is section heading of variant.
+	Heading ViewText `json:"heading"`
+
+	// Anchor is heading anchor of variant section.
+	Anchor string `json:"anchor"`
+
+	// Label is short variant name from title, reference, const or type.
+	Label ViewText `json:"label"`
+
+	// Condition is discriminator phrase such as "when `kind` = `local`".
+	Condition ViewText `json:"condition"`
+
+	// Reference is referenced definition name when variant is `$ref` to documented definition.
+	Reference ViewText `json:"reference"`
+
+	// Description is normalized variant description.
+	Description ViewText `json:"description"`
+
+	// Attributes lists variant metadata.
+	Attributes []AttributeView `json:"attributes,omitempty"`
+
+	// Rules lists conditional and dependency rules in prose.
+	Rules []ViewText `json:"rules,omitempty"`
+
+	// Properties lists properties declared by variant.
+	Properties []PropertyView `json:"properties,omitempty"`
+
+	// Index is one-based position of variant in composition keyword.
+	Index int `json:"index"`
+}
+
+// IndexEntryView is one leaf property path of flattened settings index.
+type IndexEntryView struct {
+	// Path is dotted property path; direct root properties use their key.
+	Path ViewText `json:"path"`
+
+	// Anchor is heading anchor of property section documenting path.
+	Anchor string `json:"anchor"`
+
+	// Type is property type or referenced definition.
+	Type ViewText `json:"type"`
+
+	// Required is `yes` or `no`; empty for map entries.
+	Required ViewText `json:"required"`
+
+	// Default is property default value, empty when not set.
+	Default ViewText `json:"default"`
+
+	// Description is first paragraph of property description joined into one line.
+	Description ViewText `json:"description"`
+}
+
+// AttributeView is one name/value metadata item.
+type AttributeView struct {
+	// Name is attribute label, for example `Type` or `Default`.
+	Name string `json:"name"`
+
+	// Keyword is keyword form of value when Value is prose, for example constraints.
+	Keyword *ViewText `json:"keyword,omitempty"`
+
+	// Value is attribute value.
+	Value ViewText `json:"value"`
+}
+
+// BuildViewFile reads schema from file and returns documentation view model.
+func BuildViewFile(path string, opt Options) (View, error) {
+	schemaBytes, err := os.ReadFile(path)
+	if err != nil {
+		return View{}, fmt.Errorf("%w: %w", ErrReadSchemaFile, err)
+	}
+
+	if strings.TrimSpace(opt.SourcePath) == "" {
+		opt.SourcePath = path
+	}
+
+	if strings.TrimSpace(opt.BaseDir) == "" {
+		opt.BaseDir = filepath.Dir(path)
+	}
+
+	return BuildView(schemaBytes, opt)
+}
+
+// BuildView returns documentation view model of schema bytes.
+//
+// Template and output format options are ignored.
+func BuildView(schemaBytes []byte, opt Options) (View, error) {
+	view, err := loadRenderView(schemaBytes, opt)
+	if err != nil {
+		return View{}, err
+	}
+
+	return exportView(newDocumentLinker(view.Definitions).linkView(view)), nil
+}
+
+// BuildViewJSON returns documentation view model of schema bytes encoded as pretty JSON.
+func BuildViewJSON(schemaBytes []byte, opt Options) ([]byte, error) {
+	view, err := BuildView(schemaBytes, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := marshalIndentedJSON(view)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrEncodeViewJSON, err)
+	}
+
+	return data, nil
+}
+
+// exportView converts template view into public view model.
+func exportView(view renderView) View {
+	out := View{
+		Version:      ViewVersion,
+		Title:        view.Title,
+		SourceSchema: inlineText(view.SourceSchema),
+		SchemaID:     inlineText(view.SchemaID),
+		SchemaDraft:  inlineText(view.SchemaDraft),
+		DraftSupport: inlineText(view.SchemaDraftSupport),
+		RootRef:      inlineText(view.RootRef),
+		Definitions:  make([]DefinitionView, 0, len(view.Definitions)),
+	}
+
+	if view.ExampleDocument != "" {
+		out.Example = &ViewExample{Format: ExampleFormat(view.ExampleFormat), Document: view.ExampleDocument}
+	}
+
+	for _, definition := range view.Definitions {
+		out.Definitions = append(out.Definitions, DefinitionView{
+			Name:         inlineText(definition.Name),
+			Anchor:       markdownHeadingAnchor(definition.Name),
+			Description:  blockText(definition.Description),
+			Attributes:   exportAttributes(definition.Attributes),
+			Rules:        exportRules(definition.Rules),
+			Variants:     exportVariants(definition.Variants),
+			Properties:   exportProperties(definition.Properties),
+			ReferencedBy: exportReferences(definition.ReferencedBy),
+		})
+	}
+
+	out.Index = exportIndex(view.Index)
+	return out
+}
+
+// exportIndex converts settings index entries into public ones.
+func exportIndex(entries []indexEntry) []IndexEntryView {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	out := make([]IndexEntryView, 0, len(entries))
+	for _, entry := range entries {
+		out = append(out, IndexEntryView{
+			Path:        inlineText(entry.Path),
+			Anchor:      strings.TrimPrefix(entry.Href, "#"),
+			Type:        inlineText(entry.Type),
+			Required:    inlineText(entry.Required),
+			Default:     inlineText(entry.Default),
+			Description: inlineText(entry.Description),
+		})
+	}
+
+	return out
+}
+
+// exportReferences converts template back-references into public ones.
+func exportReferences(references []referenceView) []ReferenceView {
+	if len(references) == 0 {
+		return nil
+	}
+
+	out := make([]ReferenceView, 0, len(references))
+	for _, reference := range references {
+		paths := make([]ViewText, 0, len(reference.Paths))
+		for _, path := range reference.Paths {
+			paths = append(paths, inlineText(path))
+		}
+
+		out = append(out, ReferenceView{
+			Definition: inlineText(reference.Definition),
+			Anchor:     markdownHeadingAnchor(reference.Definition),
+			Paths:      paths,
+		})
+	}
+
+	return out
+}
+
+// exportProperties converts template property views into public ones.
+func exportProperties(properties []propertyView) []PropertyView {
+	if len(properties) == 0 {
+		return nil
+	}
+
+	out := make([]PropertyView, 0, len(properties))
+	for _, property := range properties {
+		paths := make([]ViewText, 0, len(property.Paths))
+		for _, path := range property.Paths {
+			paths = append(paths, inlineText(path))
+		}
+
+		out = append(out, PropertyView{
+			Key:         inlineText(property.Name),
+			Heading:     inlineText(property.Heading),
+			Anchor:      markdownHeadingAnchor(property.Heading),
+			Paths:       paths,
+			Description: blockText(property.Description),
+			Attributes:  exportAttributes(property.Attributes),
+			Rules:       exportRules(property.Rules),
+			Variants:    exportVariants(property.Variants),
+			Depth:       property.Depth,
+		})
+	}
+
+	return out
+}
+
+// exportVariants converts template variant views into public ones.
+func exportVariants(variants []variantView) []VariantView {
+	if len(variants) == 0 {
+		return nil
+	}
+
+	out := make([]VariantView, 0, len(variants))
+	for _, variant := range variants {
+		out = append(out, VariantView{
+			Keyword:     variant.Keyword,
+			Heading:     inlineText(variant.Heading),
+			Anchor:      markdownHeadingAnchor(variant.Heading),
+			Label:       inlineText(variant.Label),
+			Condition:   inlineText(variant.Condition),
+			Reference:   inlineText(variant.Reference),
+			Description: blockText(variant.Description),
+			Attributes:  exportAttributes(variant.Attributes),
+			Rules:       exportRules(variant.Rules),
+			Properties:  exportProperties(variant.Properties),
+			Index:       variant.Index,
+		})
+	}
+
+	return out
+}
+
+// exportAttributes converts template attribute views into public ones.
+func exportAttributes(attributes []attributeView) []AttributeView {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	out := make([]AttributeView, 0, len(attributes))
+	for _, attribute := range attributes {
+		exported := AttributeView{Name: attribute.Name, Value: inlineText(attribute.Value)}
+		if attribute.Raw != "" {
+			keyword := inlineText(attribute.Raw)
+			exported.Keyword = &keyword
+		}
+
+		out = append(out, exported)
+	}
+
+	return out
+}
+
+// exportRules converts prose rules into public text values.
+func exportRules(rules []string) []ViewText {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	out := make([]ViewText, 0, len(rules))
+	for _, rule := range rules {
+		out = append(out, inlineText(rule))
+	}
+
+	return out
+}
+
+// inlineText pairs markdown inline value with its plain text.
+func inlineText(markdown string) ViewText {
+	return ViewText{Markdown: markdown, Raw: markupPlainText(markdown)}
+}
+
+// blockText pairs markdown description with its plain text; blocks are separated by blank lines.
+func blockText(markdown string) ViewText {
+	blocks := parseMarkupBlocks(markdown)
+	parts := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		switch block.Kind {
+		case markupBulletList, markupOrderedList:
+			items := make([]string, 0, len(block.Items))
+			for _, item := range block.Items {
+				items = append(items, markupPlainText(item))
+			}
+
+			parts = append(parts, strings.Join(items, "\n"))
+		case markupCodeBlock:
+			parts = append(parts, block.Text)
+		default:
+			parts = append(parts, markupPlainText(block.Text))
+		}
+	}
+
+	return ViewText{Markdown: markdown, Raw: strings.Join(parts, "\n\n")}
+}
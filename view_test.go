@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: AGPL-3.0-only
+// Copyright (c) 2026 WoozyMasta
+// Source: github.com/woozymasta/schemadoc
+
+package schemadoc
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBuildViewCarriesMarkdownAndRawText(t *testing.T) {
+	t.Parallel()
+
+	view, err := BuildView(minimalSchemaBytes(t, map[string]any{
+		"$ref": "#/$defs/Config",
+		"$defs": map[string]any{
+			"Config": map[string]any{
+				"type":        "object",
+				"description": "Settings of **demo**.\n\n- first\n- second",
+				"properties": map[string]any{
+					"log_level": map[string]any{
+						"type":      "string",
+						"minLength": 1,
+					},
+					"backend": map[string]any{"oneOf": []any{
+						map[string]any{"title": "Local", "type": "object", "properties": map[string]any{"kind": map[string]any{"const": "local"}}},
+						map[string]any{"title": "Remote", "type": "object", "properties": map[string]any{"kind": map[string]any{"const": "remote"}}},
+					}},
+				},
+			},
+		},
+	}), Options{Title: "demo", ExampleFormat: ExampleFormatJSON})
+	if err != nil {
+		t.Fatalf("BuildView: %v", err)
+	}
+
+	if view.Version != ViewVersion {
+		t.Fatalf("Version = %d, want %d", view.Version, ViewVersion)
+	}
+
+	if view.Example == nil || view.Example.Format != ExampleFormatJSON {
+		t.Fatalf("Example = %+v, want json example", view.Example)
+	}
+
+	if len(view.Definitions) != 1 {
+		t.Fatalf("Definitions = %d, want 1", len(view.Definitions))
+	}
+
+	definition := view.Definitions[0]
+	if definition.Anchor != "config" {
+		t.Fatalf("Anchor = %q, want config", definition.Anchor)
+	}
+
+	if definition.Description.Raw != "Settings of demo.\n\nfirst\nsecond" {
+		t.Fatalf("Description.Raw = %q", definition.Description.Raw)
+	}
+
+	var logLevel *PropertyView
+	for index := range definition.Properties {
+		if definition.Properties[index].Key.Raw == "log_level" {
+			logLevel = &definition.Properties[index]
+		}
+	}
+
+	if logLevel == nil {
+		t.Fatalf("log_level property not found in %+v", definition.Properties)
+	}
+
+	if value := logLevel.Attributes[0].Value; value.Markdown != "`string`" || value.Raw != "string" {
+		t.Fatalf("Type value = %+v, want code span and raw string", value)
+	}
+
+	if logLevel.Anchor != "configlog-level" {
+		t.Fatalf("Anchor = %q, want configlog-level", logLevel.Anchor)
+	}
+
+	var constraints *AttributeView
+	for index := range logLevel.Attributes {
+		if logLevel.Attributes[index].Name == "Constraints" {
+			constraints = &logLevel.Attributes[index]
+		}
+	}
+
+	if constraints == nil || constraints.Keyword == nil {
+		t.Fatalf("Constraints attribute with keyword form not found in %+v", logLevel.Attributes)
+	}
+
+	if constraints.Keyword.Raw != "minLength=1" {
+		t.Fatalf("Keyword.Raw = %q, want minLength=1", constraints.Keyword.Raw)
+	}
+
+	var backend *PropertyView
+	for index := range definition.Properties {
+		if definition.Properties[index].Key.Raw == "backend" {
+			backend = &definition.Properties[index]
+		}
+	}
+
+	if backend == nil || len(backend.Variants) != 2 {
+		t.Fatalf("backend variants not found in %+v", definition.Properties)
+	}
+
+	if variant := backend.Variants[1]; variant.Index != 2 || variant.Keyword != "oneOf" || variant.Label.Raw != "Remote" {
+		t.Fatalf("variant = %+v, want oneOf 2 Remote", variant)
+	}
+}
+
+func TestBuildViewJSONUsesSnakeCaseKeys(t *testing.T) {
+	t.Parallel()
+
+	data, err := BuildViewJSON(minimalSchemaBytes(t, map[string]any{
+		"type":       "object",
+		"properties": map[string]any{"name": map[string]any{"type": "string"}},
+	}), Options{})
+	if err != nil {
+		t.Fatalf("BuildViewJSON: %v", err)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("decode view json: %v", err)
+	}
+
+	for _, key := range []string{"version", "title", "schema_id", "draft_support", "root_ref", "definitions"} {
+		if _, ok := decoded[key]; !ok {
+			t.Fatalf("view json missing key %q:\n%s", key, data)
+		}
+	}
+
+	if _, ok := decoded["example"]; ok {
+		t.Fatalf("view json has example without example format:\n%s", data)
+	}
+
+	if _, ok := decoded["index"]; ok {
+		t.Fatalf("view json has index without settings index:\n%s", data)
+	}
+}
+
+func TestBuildViewIncludesSettingsIndex(t *testing.T) {
+	t.Parallel()
+
+	view, err := BuildView(indexSchemaBytes(t), Options{SettingsIndex: true})
+	if err != nil {
+		t.Fatalf("BuildView: %v", err)
+	}
+
+	if len(view.Index) != 4 {
+		t.Fatalf("unexpected index: %+v", view.Index)
+	}
+
+	port := view.Index[1]
+	if port.Path.Raw != "server.port" || port.Anchor != "serverport" || port.Type.Raw != "integer" ||
+		port.Required.Raw != "no" || port.Default.Markdown != "`8080`" || port.Description.Raw != "Listen port." {
+		t.Fatalf("unexpected index entry: %+v", port)
+	}
+
+	if tag := view.Index[0]; tag.Path.Raw != "server-tag" || tag.Description.Raw != "Tag of server | host." {
+		t.Fatalf("unexpected index entry: %+v", tag)
+	}
+
+	data, err := BuildViewJSON(indexSchemaBytes(t), Options{SettingsIndex: true})
+	if err != nil {
+		t.Fatalf("BuildViewJSON: %v", err)
+	}
+
+	assertContains(t, string(data), `"index": [`)
+}